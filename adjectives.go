@@ -0,0 +1,120 @@
+package fwew_lib
+
+import (
+	"strings"
+)
+
+// How an adjective is used in a phrase
+type AdjectiveUse int
+
+const (
+	AdjectiveNone        AdjectiveUse = iota // not an adjective
+	AdjectivePredicative                     // without the a, like in "ioang lu apxa"
+	AdjectiveBefore                          // before its noun with -a, like "ngima ioang" or "apxa ioang"
+	AdjectiveAfter                           // after its noun with a-, like "ioang angim" or "ioang apxa"
+)
+
+// An adjective ready to go with a noun
+type AttributiveForm struct {
+	Navi      string
+	Breakdown string // syllables, like "ngi-ma"
+	Use       AdjectiveUse
+}
+
+// le- adjectives that still take the a after their noun
+var leAdjectiveExceptions = []string{"ler", "leyr", "lewnga'"}
+
+// The two attributive forms of an adjective, ADJ-a (before the noun) and a-ADJ (after it).
+// An adjective that already ends in a doesn't get another one (apxa ioang), same for one that starts with a (ioang apxa),
+// and le- adjectives don't need one after the noun (tute le'eylan).
+// After ll or rr the a is its own syllable (kxamtrr-a), after a consonant it takes the consonant (ngi-ma)
+func AttributiveForms(adjective string) (forms []AttributiveForm, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	adjective = strings.ToLower(strings.TrimSpace(adjective))
+	if len(adjective) == 0 {
+		return nil, InvalidNavi
+	}
+	for _, a := range strings.Fields(adjective) {
+		if validation := ValidateNavi(a); validation.Verdict == VerdictInvalid {
+			return nil, InvalidNavi.wrap(constError(validation.Violations[0].Rule))
+		}
+	}
+
+	before := adjective
+	if needsAttributiveA(adjective, false, DialectForest) {
+		before += "a"
+	}
+	after := adjective
+	if needsAttributiveA(adjective, true, DialectForest) {
+		after = "a" + adjective
+	}
+
+	return []AttributiveForm{
+		{before, attributiveBreakdown(before), AdjectiveBefore},
+		{after, attributiveBreakdown(after), AdjectiveAfter},
+	}, nil
+}
+
+// If an adjective needs the a on the side of its noun.  In forest, one that already starts or ends with a on that side
+// doesn't get another, and after the noun le- adjectives (and lafyon) go without it
+func needsAttributiveA(adjective string, afterNoun bool, dialect Dialect) bool {
+	adjective = strings.ToLower(adjective)
+	if !afterNoun {
+		return !strings.HasSuffix(adjective, "a") || dialect != DialectForest
+	}
+	if strings.HasPrefix(adjective, "a") && dialect == DialectForest {
+		return false
+	}
+	if strings.HasPrefix(adjective, "le") && !ContainsStr(leAdjectiveExceptions, adjective) {
+		return false
+	}
+	return adjective != "lafyon"
+}
+
+func attributiveBreakdown(words string) string {
+	breakdowns := []string{}
+	for _, a := range strings.Fields(words) {
+		breakdowns = append(breakdowns, ValidateNavi(a).Breakdown)
+	}
+	return strings.Join(breakdowns, " ")
+}
+
+// Find which side of its noun every adjective is on.  Adjectives that start or end with a only show it
+// by where they are, so the ATTR goes in their root's gloss (ATTR.large or large.ATTR)
+func glossAdjectives(line GlossLine) {
+	// Nouns with le- are adjectives, like lefpom
+	derived := func(i int) bool {
+		return ContainsStr(line[i].Root.Affixes.Prefix, "le")
+	}
+	nounAt := func(i int) bool {
+		return i >= 0 && i < len(line) && isNoun(line[i].Root.PartOfSpeech) && !derived(i)
+	}
+
+	for i := range line {
+		root := line[i].Root
+		if !strings.HasPrefix(root.PartOfSpeech, adj) && !derived(i) {
+			continue
+		}
+		navi := strings.ToLower(root.Navi)
+		stem := len(root.Affixes.Prefix)
+
+		switch {
+		case ContainsStr(root.Affixes.Prefix, "a"):
+			line[i].Adjective = AdjectiveAfter
+		case ContainsStr(root.Affixes.Suffix, "a"):
+			line[i].Adjective = AdjectiveBefore
+		case strings.HasPrefix(navi, "a") && nounAt(i-1):
+			line[i].Adjective = AdjectiveAfter
+			line[i].Morphemes[stem].Gloss = glossPrefixes["a"] + "." + line[i].Morphemes[stem].Gloss
+		case (derived(i) || !needsAttributiveA(navi, true, DialectForest)) && nounAt(i-1):
+			line[i].Adjective = AdjectiveAfter // le- adjectives don't need the a
+		case strings.HasSuffix(navi, "a") && nounAt(i+1):
+			line[i].Adjective = AdjectiveBefore
+			line[i].Morphemes[stem].Gloss += "." + glossSuffixes["a"]
+		default:
+			line[i].Adjective = AdjectivePredicative
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAttributiveForms(t *testing.T) {
+	tests := []struct {
+		adjective string
+		want      []AttributiveForm
+	}{
+		{"ngim", []AttributiveForm{{"ngima", "ngi-ma", AdjectiveBefore}, {"angim", "a-ngim", AdjectiveAfter}}},
+		{"apxa", []AttributiveForm{{"apxa", "a-pxa", AdjectiveBefore}, {"apxa", "a-pxa", AdjectiveAfter}}},
+		{"kxamtrr", []AttributiveForm{{"kxamtrra", "kxam-trr-a", AdjectiveBefore}, {"akxamtrr", "a-kxam-trr", AdjectiveAfter}}},
+		{"ean", []AttributiveForm{{"eana", "e-a-na", AdjectiveBefore}, {"aean", "a-e-an", AdjectiveAfter}}},
+		{"le'eylan", []AttributiveForm{{"le'eylana", "le-'ey-la-na", AdjectiveBefore}, {"le'eylan", "le-'ey-lan", AdjectiveAfter}}},
+		{"ler", []AttributiveForm{{"lera", "le-ra", AdjectiveBefore}, {"aler", "a-ler", AdjectiveAfter}}},
+		{"ngay", []AttributiveForm{{"ngaya", "nga-ya", AdjectiveBefore}, {"angay", "a-ngay", AdjectiveAfter}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.adjective, func(t *testing.T) {
+			got, err := AttributiveForms(tt.adjective)
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AttributiveForms() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := AttributiveForms("xq"); err == nil {
+		t.Errorf("AttributiveForms() error = nil, want an error")
+	}
+}
+
+func Test_needsAttributiveA(t *testing.T) {
+	tests := []struct {
+		adjective string
+		afterNoun bool
+		dialect   Dialect
+		want      bool
+	}{
+		{"ngim", true, DialectForest, true},
+		{"apxa", true, DialectForest, false},
+		{"apxa", true, DialectReef, true}, // aapxa
+		{"apxa", false, DialectForest, false},
+		{"apxa", false, DialectReef, true},
+		{"le'eylan", true, DialectForest, false},
+		{"le'eylan", false, DialectForest, true},
+		{"leyr", true, DialectForest, true},
+		{"lafyon", true, DialectReef, false},
+	}
+	for _, tt := range tests {
+		if got := needsAttributiveA(tt.adjective, tt.afterNoun, tt.dialect); got != tt.want {
+			t.Errorf("needsAttributiveA(%q, %v, %v) = %v, want %v", tt.adjective, tt.afterNoun, tt.dialect, got, tt.want)
+		}
+	}
+}
+
+func TestGlossAdjectives(t *testing.T) {
+	CacheDictHash()
+	tests := []struct {
+		sentence string
+		gloss    []string
+		uses     []AdjectiveUse
+	}{
+		{"ioang apxa", []string{"animal", "ATTR.large"}, []AdjectiveUse{AdjectiveNone, AdjectiveAfter}},
+		{"apxa ioang", []string{"large.ATTR", "animal"}, []AdjectiveUse{AdjectiveBefore, AdjectiveNone}},
+		{"ngima ioang", []string{"long-ATTR", "animal"}, []AdjectiveUse{AdjectiveBefore, AdjectiveNone}},
+		{"ioang angim", []string{"animal", "ATTR-long"}, []AdjectiveUse{AdjectiveNone, AdjectiveAfter}},
+		{"apxa", []string{"large"}, []AdjectiveUse{AdjectivePredicative}},
+		{"ioang a apxa", []string{"animal", "REL", "large"}, []AdjectiveUse{AdjectiveNone, AdjectiveNone, AdjectivePredicative}},
+		{"lefpoma tute", []string{"ADJZ-well-being-ATTR", "person"}, []AdjectiveUse{AdjectiveBefore, AdjectiveNone}},
+		{"tute alefpom", []string{"person", "ATTR-ADJZ-well-being"}, []AdjectiveUse{AdjectiveNone, AdjectiveAfter}},
+		{"tute le'eylan", []string{"person", "friendly"}, []AdjectiveUse{AdjectiveNone, AdjectiveAfter}},
+		{"tute lu lefpom", []string{"person", "be", "ADJZ-well-being"}, []AdjectiveUse{AdjectiveNone, AdjectiveNone, AdjectivePredicative}},
+		{"tute lu apxa", []string{"person", "be", "large"}, []AdjectiveUse{AdjectiveNone, AdjectiveNone, AdjectivePredicative}}, // vin. isn't n.
+		{"ioang a lu apxa", []string{"animal", "REL", "be", "large"}, []AdjectiveUse{AdjectiveNone, AdjectiveNone, AdjectiveNone, AdjectivePredicative}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sentence, func(t *testing.T) {
+			line, err := Gloss(tt.sentence, "en")
+			if err != nil {
+				t.Fatalf("Gloss() error = %v", err)
+			}
+			gloss := []string{}
+			uses := []AdjectiveUse{}
+			for _, a := range line {
+				_, l := a.Lines()
+				gloss = append(gloss, l)
+				uses = append(uses, a.Adjective)
+			}
+			if !reflect.DeepEqual(gloss, tt.gloss) || !reflect.DeepEqual(uses, tt.uses) {
+				t.Errorf("Gloss() = %v %v, want %v %v", gloss, uses, tt.gloss, tt.uses)
+			}
+		})
+	}
+}
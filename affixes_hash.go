@@ -27,8 +27,13 @@ func candidateDupe(candidate ConjugationCandidate) (c ConjugationCandidate) {
 	return a
 }
 
-var candidates []ConjugationCandidate
-var candidateMap = map[string]ConjugationCandidate{}
+// What one Deconjugate call has found so far.  Every call has its own, so more than one word
+// can be deconjugated at a time
+type deconjugation struct {
+	candidates   []ConjugationCandidate
+	candidateMap map[string]ConjugationCandidate
+}
+
 var unlenitionLetters = []string{
 	"ts", "kx", "tx", "px", // traps digraphs because they cannot unlenite
 	"f", "p", "h", "k", "s",
@@ -315,8 +320,8 @@ var productiveCompounds = map[string][][]string{
 	"tseyä":          forbiddenTsaw,
 }
 
-func isDuplicate(input ConjugationCandidate) bool {
-	if a, ok := candidateMap[input.Word]; ok {
+func (d *deconjugation) isDuplicate(input ConjugationCandidate) bool {
+	if a, ok := d.candidateMap[input.Word]; ok {
 		if input.InsistPOS == a.InsistPOS {
 			if len(input.Prefixes) == len(a.Prefixes) && len(input.Suffixes) == len(a.Suffixes) {
 				if len(input.Infixes) == len(a.Infixes) {
@@ -531,10 +536,10 @@ func verifyCaseEnding(noun string, ending string) bool {
 	return false
 }
 
-func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck int, unlenite int8,
+func (d *deconjugation) deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck int, unlenite int8,
 	infix []string, lastPrefix string, lastSuffix string, strict bool, allowReef bool) []ConjugationCandidate {
-	if isDuplicate(input) {
-		return candidates
+	if d.isDuplicate(input) {
+		return d.candidates
 	}
 
 	vowels := "aäeiìouù"
@@ -547,7 +552,7 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 			if !implContainsAny(prefixes1lenition, []string{lastPrefix}) { // do not do this for leniting prefixes
 				newCandidate := candidateDupe(input)
 				newCandidate.Word = "'" + newCandidate.Word
-				deconjugateHelper(newCandidate, prefixCheck, suffixCheck, unlenite, infix, "", "", strict, allowReef)
+				d.deconjugateHelper(newCandidate, prefixCheck, suffixCheck, unlenite, infix, "", "", strict, allowReef)
 			}
 		}
 
@@ -555,7 +560,7 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 		if len(lastSuffix) > 0 && len(input.Word) > 0 && hasAt(vowels, lastSuffix, 0) && hasAt(vowels, input.Word, -1) {
 			newCandidate := candidateDupe(input)
 			newCandidate.Word += "'"
-			deconjugateHelper(newCandidate, prefixCheck, suffixCheck, unlenite, infix, "", "", strict, allowReef)
+			d.deconjugateHelper(newCandidate, prefixCheck, suffixCheck, unlenite, infix, "", "", strict, allowReef)
 		}
 	}
 
@@ -563,11 +568,11 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 	if len(input.Suffixes) == 1 {
 		if validWord, ok := weirdNounSuffixes[input.Word]; ok {
 			input.Word = validWord
-			if !isDuplicate(input) {
-				candidates = append(candidates, input)
-				candidateMap[input.Word] = input
+			if !d.isDuplicate(input) {
+				d.candidates = append(d.candidates, input)
+				d.candidateMap[input.Word] = input
 			}
-			return candidates
+			return d.candidates
 		}
 	}
 
@@ -576,16 +581,16 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 		// confirmed in here: https://forum.learnnavi.org/index.php?msg=493217
 		if input.Word == "zeneke" {
 			input.Word = "zenke"
-			if !isDuplicate(input) {
-				candidates = append(candidates, input)
-				candidateMap[input.Word] = input
+			if !d.isDuplicate(input) {
+				d.candidates = append(d.candidates, input)
+				d.candidateMap[input.Word] = input
 			}
-			return candidates
+			return d.candidates
 		}
 	}
 
-	candidates = append(candidates, input)
-	candidateMap[input.Word] = input
+	d.candidates = append(d.candidates, input)
+	d.candidateMap[input.Word] = input
 
 	// Add a way for e to become ä again if we're down to 1 syllable
 	if !strict && allowReef && len([]rune(input.Word)) < 8 && (len(input.Prefixes) > 0 ||
@@ -593,7 +598,7 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 		// could be tskxäpx (7 letters 1 syllable)
 		newCandidate := candidateDupe(input)
 		newCandidate.Word = strings.ReplaceAll(newCandidate.Word, "e", "ä")
-		deconjugateHelper(newCandidate, prefixCheck, suffixCheck, unlenite, infix, "", "", strict, allowReef)
+		d.deconjugateHelper(newCandidate, prefixCheck, suffixCheck, unlenite, infix, "", "", strict, allowReef)
 	}
 
 	newString := ""
@@ -605,9 +610,9 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 			newCandidate.Word = strings.TrimSuffix(input.Word, "tswo") + " si"
 			newCandidate.InsistPOS = "v."
 			newCandidate.Suffixes, added = isDuplicateFix(newCandidate.Suffixes, "tswo", strict, allowReef)
-			if added && !isDuplicate(newCandidate) {
-				candidates = append(candidates, newCandidate)
-				candidateMap[input.Word] = input
+			if added && !d.isDuplicate(newCandidate) {
+				d.candidates = append(d.candidates, newCandidate)
+				d.candidateMap[input.Word] = input
 			}
 		}
 	}
@@ -681,9 +686,9 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 				}
 			}
 
-			if !isDuplicate(input) {
-				candidates = append(candidates, input)
-				candidateMap[input.Word] = input
+			if !d.isDuplicate(input) {
+				d.candidates = append(d.candidates, input)
+				d.candidateMap[input.Word] = input
 			} // to bump the real candidate into recognition
 
 			if found {
@@ -698,12 +703,26 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 				if aPosition == 1 {
 					newCandidate.Suffixes = append(newCandidate.Suffixes, "a")
 				}
-				if !isDuplicate(newCandidate) {
-					candidates = append(candidates, newCandidate)
-					candidateMap[input.Word] = input
+				if !d.isDuplicate(newCandidate) {
+					d.candidates = append(d.candidates, newCandidate)
+					d.candidateMap[input.Word] = input
 				}
 			}
-			return candidates
+			return d.candidates
+		}
+	}
+
+	// le- makes an adjective out of a noun, like lefpom.  Only once the a is off (or with no affixes at all,
+	// like tute lu lefpom), and only if it isn't already a word, so le'eylan isn't le-'eylan
+	bare := input.InsistPOS == "any" && len(input.Prefixes)+len(input.Suffixes)+len(input.Infixes)+len(input.Lenition) == 0
+	if (input.InsistPOS == "adj." || bare) && prefixCheck <= 1 && strings.HasPrefix(input.Word, "le") &&
+		len(dictHashStrict[input.Word]) == 0 && len(dictHashLoose[input.Word]) == 0 {
+		newCandidate := candidateDupe(input)
+		newCandidate.Word = strings.TrimPrefix(input.Word, "le")
+		newCandidate.Prefixes, added = isDuplicateFix(newCandidate.Prefixes, "le", strict, allowReef)
+		if added {
+			newCandidate.InsistPOS = "n."
+			d.deconjugateHelper(newCandidate, 10, 10, -1, []string{}, "le", "", strict, allowReef) // No other fixes
 		}
 	}
 
@@ -722,9 +741,9 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 			newCandidate.Prefixes, added = isDuplicateFix(newCandidate.Prefixes, "a", strict, allowReef)
 			if added {
 				newCandidate.InsistPOS = "adj."
-				deconjugateHelper(newCandidate, 1, suffixCheck, -1, []string{}, "a", "", strict, allowReef)
+				d.deconjugateHelper(newCandidate, 1, suffixCheck, -1, []string{}, "a", "", strict, allowReef)
 				newCandidate.InsistPOS = "v."
-				deconjugateHelper(newCandidate, 1, suffixCheck, -1, []string{"", "", ""}, "a", "", strict, allowReef)
+				d.deconjugateHelper(newCandidate, 1, suffixCheck, -1, []string{"", "", ""}, "a", "", strict, allowReef)
 			}
 		} else if strings.HasPrefix(input.Word, "nì") {
 			newCandidate := candidateDupe(input)
@@ -733,7 +752,7 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 			if added {
 				newCandidate.InsistPOS = "nì."
 				// No other affixes allowed
-				deconjugateHelper(newCandidate, 10, 10, -1, []string{}, "nì", "", strict, allowReef) // No other fixes
+				d.deconjugateHelper(newCandidate, 10, 10, -1, []string{}, "nì", "", strict, allowReef) // No other fixes
 			}
 		} else if !strict && strings.HasPrefix(input.Word, "ni") {
 			newCandidate := candidateDupe(input)
@@ -742,7 +761,7 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 			if added {
 				newCandidate.InsistPOS = "nì."
 				// No other affixes allowed
-				deconjugateHelper(newCandidate, 10, 10, -1, []string{}, "nì", "", strict, allowReef) // No other fixes
+				d.deconjugateHelper(newCandidate, 10, 10, -1, []string{}, "nì", "", strict, allowReef) // No other fixes
 			}
 		}
 		fallthrough
@@ -758,11 +777,11 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 				if !added {
 					continue
 				}
-				deconjugateHelper(newCandidate, 10, 10, -1, []string{}, element, "", strict, allowReef)
+				d.deconjugateHelper(newCandidate, 10, 10, -1, []string{}, element, "", strict, allowReef)
 
 				// check "tsatan", "tan" and "atan"
 				newCandidate.Word = string(get_last_rune(element, 1)) + newCandidate.Word
-				deconjugateHelper(newCandidate, 10, 10, -1, []string{}, element, "", strict, allowReef)
+				d.deconjugateHelper(newCandidate, 10, 10, -1, []string{}, element, "", strict, allowReef)
 			}
 		}
 		fallthrough
@@ -782,11 +801,11 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 					if !added {
 						continue
 					}
-					deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, element, "", strict, allowReef)
+					d.deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, element, "", strict, allowReef)
 
 					// check "tsatan", "tan" and "atan"
 					newCandidate.Word = string(get_last_rune(element, 1)) + newString
-					deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, element, "", strict, allowReef)
+					d.deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, element, "", strict, allowReef)
 				}
 			}
 		}
@@ -810,11 +829,11 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 				if hasAt(vowels, element, -1) {
 					// check "pxeyktan", "yktan" and "eyktan"
 					newCandidate.Word = string(get_last_rune(element, 1)) + newString
-					deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
+					d.deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
 
 					// check "pxeylan", "ylan" and "'eylan"
 					newCandidate.Word = "'" + newCandidate.Word
-					deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
+					d.deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
 				}
 
 				// find out the possible unlenited forms
@@ -829,14 +848,14 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 							if oldPrefix != newPrefix {
 								newCandidate.Lenition = []string{newPrefix + "→" + oldPrefix}
 							}
-							deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, oldPrefix, "", strict, allowReef)
+							d.deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, oldPrefix, "", strict, allowReef)
 						}
 						break // We don't want the "ts" to become "txs"
 					}
 				}
 				if !lenited {
 					newCandidate.Word = newString
-					deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
+					d.deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
 				}
 			}
 		}
@@ -857,11 +876,11 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 					if hasAt(vowels, "pe", -1) {
 						// check "pxeyktan", "yktan" and "eyktan"
 						newCandidate.Word = string(get_last_rune("pe", 1)) + newString
-						deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, "pe", "", strict, allowReef)
+						d.deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, "pe", "", strict, allowReef)
 
 						// check "pxeylan", "ylan" and "'eylan"
 						newCandidate.Word = "'" + newCandidate.Word
-						deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, "pe", "", strict, allowReef)
+						d.deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, "pe", "", strict, allowReef)
 					}
 
 					// find out the possible unlenited forms
@@ -876,14 +895,14 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 								if oldPrefix != newPrefix {
 									newCandidate.Lenition = []string{newPrefix + "→" + oldPrefix}
 								}
-								deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, oldPrefix, "", strict, allowReef)
+								d.deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, oldPrefix, "", strict, allowReef)
 							}
 							break // We don't want the "ts" to become "txs"
 						}
 					}
 					if !lenited {
 						newCandidate.Word = newString
-						deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, "pe", "", strict, allowReef)
+						d.deconjugateHelper(newCandidate, 3, suffixCheck, -1, []string{}, "pe", "", strict, allowReef)
 					}
 				}
 			}
@@ -902,11 +921,11 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 				newCandidate.InsistPOS = "n."
 				newCandidate.Prefixes, added = isDuplicateFix(newCandidate.Prefixes, "fra", strict, allowReef)
 				if added {
-					deconjugateHelper(newCandidate, 4, suffixCheck, -1, []string{}, "fra", "", strict, allowReef)
+					d.deconjugateHelper(newCandidate, 4, suffixCheck, -1, []string{}, "fra", "", strict, allowReef)
 
 					// check "tsatan", "tan" and "atan"
 					newCandidate.Word = string(get_last_rune("fra", 1)) + newString
-					deconjugateHelper(newCandidate, 4, suffixCheck, -1, []string{}, "fra", "", strict, allowReef)
+					d.deconjugateHelper(newCandidate, 4, suffixCheck, -1, []string{}, "fra", "", strict, allowReef)
 				}
 			}
 		}
@@ -932,11 +951,11 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 					if hasAt(vowels, element, -1) {
 						// check "pxeyktan", "yktan" and "eyktan"
 						newCandidate.Word = string(get_last_rune(element, 1)) + newString
-						deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
+						d.deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
 
 						// check "pxeylan", "ylan" and "'eylan"
 						newCandidate.Word = "'" + newCandidate.Word
-						deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
+						d.deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
 					}
 
 					// find out the possible unlenited forms
@@ -951,14 +970,14 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 								if oldPrefix != newPrefix {
 									newCandidate.Lenition = []string{newPrefix + "→" + oldPrefix}
 								}
-								deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, oldPrefix, "", strict, allowReef)
+								d.deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, oldPrefix, "", strict, allowReef)
 							}
 							break // We don't want the "ts" to become "txs"
 						}
 					}
 					if !lenited {
 						newCandidate.Word = newString
-						deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
+						d.deconjugateHelper(newCandidate, 5, suffixCheck, -1, []string{}, element, "", strict, allowReef)
 					}
 				}
 			}
@@ -977,11 +996,11 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 					if !added {
 						continue
 					}
-					deconjugateHelper(newCandidate, 6, suffixCheck, -1, []string{}, element, "", strict, allowReef)
+					d.deconjugateHelper(newCandidate, 6, suffixCheck, -1, []string{}, element, "", strict, allowReef)
 
 					// check "tsatan", "tan" and "atan"
 					newCandidate.Word = string(get_last_rune(element, 1)) + newCandidate.Word
-					deconjugateHelper(newCandidate, 6, suffixCheck, -1, []string{}, element, "", strict, allowReef)
+					d.deconjugateHelper(newCandidate, 6, suffixCheck, -1, []string{}, element, "", strict, allowReef)
 				}
 			}
 		}
@@ -995,10 +1014,10 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 				newCandidate.InsistPOS = "v."
 				newCandidate.Prefixes, added = isDuplicateFix(newCandidate.Prefixes, "tì", strict, allowReef)
 				if added {
-					deconjugateHelper(newCandidate, 10, 10, -1, []string{"", "", ""}, "tì", "", strict, allowReef) // No other prefixes allowed
+					d.deconjugateHelper(newCandidate, 10, 10, -1, []string{"", "", ""}, "tì", "", strict, allowReef) // No other prefixes allowed
 
 					newCandidate.Word = "ì" + newCandidate.Word
-					deconjugateHelper(newCandidate, 10, 10, -1, []string{"", "", ""}, "tì", "", strict, allowReef) // Or any additional suffixes
+					d.deconjugateHelper(newCandidate, 10, 10, -1, []string{"", "", ""}, "tì", "", strict, allowReef) // Or any additional suffixes
 				}
 			}
 		} else if !strict && strings.HasPrefix(input.Word, "ti") {
@@ -1009,10 +1028,10 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 				newCandidate.InsistPOS = "v."
 				newCandidate.Prefixes, added = isDuplicateFix(newCandidate.Prefixes, "tì", strict, allowReef)
 				if added {
-					deconjugateHelper(newCandidate, 10, 10, -1, []string{"", "", ""}, "tì", "", strict, allowReef) // No other prefixes allowed
+					d.deconjugateHelper(newCandidate, 10, 10, -1, []string{"", "", ""}, "tì", "", strict, allowReef) // No other prefixes allowed
 
 					newCandidate.Word = "ì" + newCandidate.Word
-					deconjugateHelper(newCandidate, 10, 10, -1, []string{"", "", ""}, "tì", "", strict, allowReef) // Or any additional suffixes
+					d.deconjugateHelper(newCandidate, 10, 10, -1, []string{"", "", ""}, "tì", "", strict, allowReef) // Or any additional suffixes
 				}
 			}
 		}
@@ -1025,12 +1044,12 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 			newCandidate := candidateDupe(input)
 			newCandidate.Word = strings.TrimSuffix(newCandidate.Word, "sì")
 			newCandidate.Suffixes = append(newCandidate.Suffixes, "sì")
-			deconjugateHelper(newCandidate, newPrefixCheck, 1, unlenite, infix, "", "sì", strict, allowReef)
+			d.deconjugateHelper(newCandidate, newPrefixCheck, 1, unlenite, infix, "", "sì", strict, allowReef)
 		} else if !strict && len(input.Suffixes) == 0 && strings.HasSuffix(input.Word, "si") {
 			newCandidate := candidateDupe(input)
 			newCandidate.Word = strings.TrimSuffix(newCandidate.Word, "si")
 			newCandidate.Suffixes = append(newCandidate.Suffixes, "sì")
-			deconjugateHelper(newCandidate, newPrefixCheck, 1, unlenite, infix, "", "sì", strict, allowReef)
+			d.deconjugateHelper(newCandidate, newPrefixCheck, 1, unlenite, infix, "", "sì", strict, allowReef)
 		}
 		// special case: short genitives of pronouns like "oey" and "ngey"
 		if input.InsistPOS == "any" || input.InsistPOS == "n." {
@@ -1041,13 +1060,13 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 				newCandidate.InsistPOS = "pn."
 				newCandidate.Suffixes, added = isDuplicateFix(newCandidate.Suffixes, "y", strict, allowReef)
 				if added {
-					deconjugateHelper(newCandidate, newPrefixCheck, 10, unlenite, []string{}, "", "y", strict, allowReef)
+					d.deconjugateHelper(newCandidate, newPrefixCheck, 10, unlenite, []string{}, "", "y", strict, allowReef)
 
 					// ngey to nga
 					if strings.HasSuffix(newCandidate.Word, "e") {
 						newCandidate.Word = strings.TrimSuffix(newCandidate.Word, "e") + "a"
 						newCandidate.InsistPOS = "pn."
-						deconjugateHelper(newCandidate, newPrefixCheck, 10, unlenite, []string{}, "", "y", strict, allowReef)
+						d.deconjugateHelper(newCandidate, newPrefixCheck, 10, unlenite, []string{}, "", "y", strict, allowReef)
 					}
 				}
 			}
@@ -1073,59 +1092,59 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 						continue
 					}
 					// all set to 2 to avoid mengeyä -> mengo -> me + 'eng + o
-					deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
+					d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
 
 					if oldSuffix == "ä" && !strings.HasSuffix(input.Word, "yä") && strings.HasSuffix(input.Word, "iä") { // Don't make peyä -> yä -> ya (air)
 						// soaiä, tìftiä, etx.
 						newString += "a"
 						newCandidate.Word = newString
-						deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
+						d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
 					} else if allowReef && oldSuffix == "e" && !strings.HasSuffix(input.Word, "ye") && strings.HasSuffix(input.Word, "ie") {
 						// reef of above
 						newString += "a"
 						newCandidate.Word = newString
-						deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "ä", strict, allowReef)
+						d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "ä", strict, allowReef)
 					} else if (oldSuffix == "yä" || (allowReef && oldSuffix == "ye")) && strings.HasSuffix(newString, "e") {
 						// A one-off
 						if newString == "tse" {
 							newCandidate.Word = "tsaw"
-							deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
+							d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
 						}
 						// ngeyä -> nga
 						newCandidate.Word = strings.TrimSuffix(newString, "e") + "a"
-						deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
+						d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
 						// oengeyä
 						newCandidate.Word = strings.TrimSuffix(newString, "e")
 						if newCandidate.Word == "oeng" { //no mengeyä -> meng -> me + 'eng
-							deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
+							d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
 						}
 						// sneyä -> sno
 						newCandidate.Word = strings.TrimSuffix(newString, "e") + "o"
-						deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
+						d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", oldSuffix, strict, allowReef)
 					} else if !strict && oldSuffix == "ye" && strings.HasSuffix(newString, "e") {
 						// reef of above
 						if newString == "tse" {
 							newCandidate.Word = "tsaw"
-							deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "yä", strict, allowReef)
+							d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "yä", strict, allowReef)
 						}
 						// ngeye -> nga
 						newCandidate.Word = strings.TrimSuffix(newString, "e") + "a"
-						deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "yä", strict, allowReef)
+						d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "yä", strict, allowReef)
 						// oengeye
 						newCandidate.Word = strings.TrimSuffix(newString, "e")
 						if newCandidate.Word == "oeng" { //no mengeyä -> meng -> me + 'eng
-							deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "yä", strict, allowReef)
+							d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "yä", strict, allowReef)
 						}
 						// sneye -> sno
 						newCandidate.Word = strings.TrimSuffix(newString, "e") + "o"
-						deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "yä", strict, allowReef)
+						d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "yä", strict, allowReef)
 					} else if vowels, ok := vowelSuffixes["yä"]; ok {
 						for _, vowel := range vowels {
 							// Make sure zekwä-äo is recognized
 							if strings.HasSuffix(newString, vowel+"-") {
 								newString = strings.TrimSuffix(newString, "-")
 								newCandidate.Word = newString
-								deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "yä", strict, allowReef)
+								d.deconjugateHelper(newCandidate, newPrefixCheck, 2, unlenite, []string{}, "", "yä", strict, allowReef)
 							}
 						}
 					}
@@ -1143,7 +1162,7 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 				newCandidate.InsistPOS = "n."
 				newCandidate.Suffixes, added = isDuplicateFix(newCandidate.Suffixes, "pe", strict, allowReef)
 				if added {
-					deconjugateHelper(newCandidate, newPrefixCheck, 4, unlenite, []string{}, "", "pe", strict, allowReef)
+					d.deconjugateHelper(newCandidate, newPrefixCheck, 4, unlenite, []string{}, "", "pe", strict, allowReef)
 				}
 			}
 		}
@@ -1159,9 +1178,9 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 			newCandidate.InsistPOS = "adj."
 			newCandidate.Suffixes, added = isDuplicateFix(newCandidate.Suffixes, "a", strict, allowReef)
 			if added {
-				deconjugateHelper(newCandidate, newPrefixCheck, 4, unlenite, []string{"", "", ""}, "", "a", strict, allowReef)
+				d.deconjugateHelper(newCandidate, newPrefixCheck, 4, unlenite, []string{"", "", ""}, "", "a", strict, allowReef)
 				newCandidate.InsistPOS = "v."
-				deconjugateHelper(newCandidate, newPrefixCheck, 4, unlenite, []string{"", "", ""}, "", "a", strict, allowReef)
+				d.deconjugateHelper(newCandidate, newPrefixCheck, 4, unlenite, []string{"", "", ""}, "", "a", strict, allowReef)
 			}
 		}
 
@@ -1176,7 +1195,7 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 				newCandidate.InsistPOS = "n."
 				newCandidate.Suffixes, added = isDuplicateFix(newCandidate.Suffixes, "o", strict, allowReef)
 				if added {
-					deconjugateHelper(newCandidate, newPrefixCheck, 4, unlenite, []string{}, "", "o", strict, allowReef)
+					d.deconjugateHelper(newCandidate, newPrefixCheck, 4, unlenite, []string{}, "", "o", strict, allowReef)
 
 					// Make sure fya'o-o is recognized
 					if vowels, ok := vowelSuffixes["o"]; ok {
@@ -1185,7 +1204,7 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 							if strings.HasSuffix(newString, vowel+"-") {
 								newString = strings.TrimSuffix(newString, "-")
 								newCandidate.Word = newString
-								deconjugateHelper(newCandidate, newPrefixCheck, 5, unlenite, []string{}, "", "o", strict, allowReef)
+								d.deconjugateHelper(newCandidate, newPrefixCheck, 5, unlenite, []string{}, "", "o", strict, allowReef)
 							}
 						}
 					}
@@ -1208,7 +1227,7 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 					if !added {
 						continue
 					}
-					deconjugateHelper(newCandidate, newPrefixCheck, 6, unlenite, []string{}, "", oldSuffix, strict, allowReef)
+					d.deconjugateHelper(newCandidate, newPrefixCheck, 6, unlenite, []string{}, "", oldSuffix, strict, allowReef)
 				}
 			}
 		}
@@ -1229,12 +1248,12 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 					if !added {
 						continue
 					}
-					deconjugateHelper(newCandidate, 10, 10, unlenite, []string{}, "", oldSuffix, strict, allowReef) // Don't allow any other prefixes
+					d.deconjugateHelper(newCandidate, 10, 10, unlenite, []string{}, "", oldSuffix, strict, allowReef) // Don't allow any other prefixes
 					// They may turn the InsistPOS back into a noun
 
 					if oldSuffix == "yu" && strings.HasSuffix(newString, "si") {
 						newCandidate.Word = strings.TrimSuffix(newString, "si") + " si"
-						deconjugateHelper(newCandidate, 10, 10, unlenite, []string{}, "", oldSuffix, strict, allowReef) // don't allow any other prefixes or suffixes
+						d.deconjugateHelper(newCandidate, 10, 10, unlenite, []string{}, "", oldSuffix, strict, allowReef) // don't allow any other prefixes or suffixes
 					}
 				}
 			}
@@ -1249,7 +1268,7 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 			newCandidate := candidateDupe(input)
 			newCandidate.Word = strings.TrimSuffix(input.Word, "si") + " si"
 			newCandidate.InsistPOS = "v."
-			deconjugateHelper(newCandidate, newPrefixCheck, suffixCheck, unlenite, infix, "", "", strict, allowReef)
+			d.deconjugateHelper(newCandidate, newPrefixCheck, suffixCheck, unlenite, infix, "", "", strict, allowReef)
 		} else { // If there is a "si", we don't need to check for infixes
 			// Check for infixes
 			runes := []rune(input.Word)
@@ -1268,20 +1287,20 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 								continue
 							}
 							newCandidate.InsistPOS = "v."
-							deconjugateHelper(newCandidate, newPrefixCheck, suffixCheck, unlenite, newInfixes, "", "", strict, allowReef)
+							d.deconjugateHelper(newCandidate, newPrefixCheck, suffixCheck, unlenite, newInfixes, "", "", strict, allowReef)
 
 							if newInfix == "ol" {
 								newCandidate := candidateDupe(input)
 								newCandidate.Word = string(runes[:i]) + "ll" + strings.TrimPrefix(shortString, newInfix)
 								newCandidate.Infixes, _ = isDuplicateFix(newCandidate.Infixes, newInfix, strict, allowReef)
 								newCandidate.InsistPOS = "v."
-								deconjugateHelper(newCandidate, newPrefixCheck, suffixCheck, unlenite, newInfixes, "", "", strict, allowReef)
+								d.deconjugateHelper(newCandidate, newPrefixCheck, suffixCheck, unlenite, newInfixes, "", "", strict, allowReef)
 							} else if newInfix == "er" {
 								newCandidate := candidateDupe(input)
 								newCandidate.Word = string(runes[:i]) + "rr" + strings.TrimPrefix(shortString, newInfix)
 								newCandidate.Infixes, _ = isDuplicateFix(newCandidate.Infixes, newInfix, strict, allowReef)
 								newCandidate.InsistPOS = "v."
-								deconjugateHelper(newCandidate, newPrefixCheck, suffixCheck, unlenite, newInfixes, "", "", strict, allowReef)
+								d.deconjugateHelper(newCandidate, newPrefixCheck, suffixCheck, unlenite, newInfixes, "", "", strict, allowReef)
 							}
 						}
 					}
@@ -1303,14 +1322,14 @@ func deconjugateHelper(input ConjugationCandidate, prefixCheck int, suffixCheck
 					if oldPrefix != newPrefix {
 						newCandidate.Lenition = []string{newPrefix + "→" + oldPrefix}
 					}
-					deconjugateHelper(newCandidate, prefixCheck, suffixCheck, -1, []string{}, "", "", strict, allowReef)
+					d.deconjugateHelper(newCandidate, prefixCheck, suffixCheck, -1, []string{}, "", "", strict, allowReef)
 				}
 				break // We don't want the "ts" to become "txs"
 			}
 		}
 	}
 
-	return candidates
+	return d.candidates
 }
 
 // Helper for TestDeconjugations
@@ -1370,15 +1389,13 @@ func allIConfigs(input string, discrimRune rune, replaceRune rune, strict bool,
 }
 
 func Deconjugate(input string, strict bool, allowReef bool) []ConjugationCandidate {
-	candidates = []ConjugationCandidate{} //empty array of strings
-	candidateMap = map[string]ConjugationCandidate{}
+	d := deconjugation{candidates: []ConjugationCandidate{}, candidateMap: map[string]ConjugationCandidate{}}
 	newCandidate := ConjugationCandidate{}
 	newCandidate.Word = input
 	newCandidate.InsistPOS = "any"
-	deconjugateHelper(newCandidate, 0, 0, 0, []string{"", "", ""}, "", "", strict, allowReef)
+	d.deconjugateHelper(newCandidate, 0, 0, 0, []string{"", "", ""}, "", "", strict, allowReef)
 
-	candidates = candidates[1:]
-	return candidates
+	return d.candidates[1:]
 }
 
 func TestDeconjugations(dict *map[string][]Word, searchNaviWord string, strict bool, allowReef bool, umlaut bool) (results []Word) {
@@ -0,0 +1,160 @@
+package fwew_lib
+
+import (
+	"slices"
+	"strings"
+)
+
+// A trie of folded keys.  Entries are indexes into the things it was made from
+type autocompleteNode struct {
+	children map[rune]*autocompleteNode
+	entries  []int
+}
+
+type autocompleteIndex struct {
+	root  *autocompleteNode
+	words []string // what gets suggested
+	keys  []string // folded
+	ranks []int    // lower is better
+}
+
+// Suggestions for what's being typed.  For Na'vi (lang "navi" or "") these are dictionary words, shortest first.
+// a finds ä, i finds ì, tìftangs can be left out and reef spellings work.  A digraph can't be split,
+// so nga won't find words with n-ga, but a k at the end can be the start of kx.
+// For the other languages they're words from the definitions, most common first
+func Autocomplete(prefix string, lang string, limit int) (results []string) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	navi := lang == "" || lang == "navi"
+	if navi {
+		lang = "navi"
+	}
+
+	// One index per language ("navi" for Na'vi), made the first time it's used
+	index := derivedIndex("autocomplete "+lang, func() (*autocompleteIndex, bool) {
+		index := newAutocompleteIndex(lang)
+		return index, len(index.words) > 0
+	})
+
+	key := natlangFolds.Replace(strings.ToLower(strings.TrimSpace(prefix)))
+	if navi {
+		key = autocompleteNaviKey(prefix)
+	}
+	if len(key) == 0 {
+		return
+	}
+
+	node := index.root
+	for _, r := range key {
+		node = node.children[r]
+		if node == nil {
+			return
+		}
+	}
+
+	// Everything under the node
+	found := []int{}
+	stack := []*autocompleteNode{node}
+	for len(stack) > 0 {
+		a := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		found = append(found, a.entries...)
+		for _, b := range a.children {
+			stack = append(stack, b)
+		}
+	}
+
+	if prefixPhonemes := splitPhonemes(key); navi && len(prefixPhonemes) > 0 {
+		found = slices.DeleteFunc(found, func(i int) bool {
+			return !autocompletePhonemesMatch(prefixPhonemes, splitPhonemes(index.keys[i]))
+		})
+	}
+
+	slices.SortFunc(found, func(a, b int) int {
+		if index.ranks[a] != index.ranks[b] {
+			return index.ranks[a] - index.ranks[b]
+		}
+		return defaultCollator.Compare(index.words[a], index.words[b])
+	})
+
+	seen := map[string]bool{}
+	for _, i := range found {
+		if seen[index.words[i]] {
+			continue
+		}
+		seen[index.words[i]] = true
+		results = append(results, index.words[i])
+		if limit > 0 && len(results) == limit {
+			break
+		}
+	}
+	return
+}
+
+func newAutocompleteIndex(lang string) *autocompleteIndex {
+	index := autocompleteIndex{root: &autocompleteNode{}}
+	add := func(word string, key string, rank int) {
+		i := len(index.words)
+		index.words = append(index.words, word)
+		index.keys = append(index.keys, key)
+		index.ranks = append(index.ranks, rank)
+
+		node := index.root
+		for _, r := range key {
+			if node.children == nil {
+				node.children = map[rune]*autocompleteNode{}
+			}
+			if node.children[r] == nil {
+				node.children[r] = &autocompleteNode{}
+			}
+			node = node.children[r]
+		}
+		node.entries = append(node.entries, i)
+	}
+
+	if lang == "navi" {
+		for _, words := range dictHashStrict {
+			for _, a := range words {
+				navi := strings.ReplaceAll(a.Navi, "+", "")
+				add(navi, autocompleteNaviKey(navi), len([]rune(navi)))
+			}
+		}
+		return &index
+	}
+
+	// More Na'vi words with it in their definitions is better
+	for term, words := range dictHash2Parenthesis.lang(lang) {
+		if len(term) > 0 {
+			add(term, natlangFolds.Replace(term), -len(words))
+		}
+	}
+	return &index
+}
+
+// Lowercase, no tìftangs, no diacritics and forest spelling
+func autocompleteNaviKey(word string) string {
+	fold := strings.NewReplacer("’", "", "‘", "", "'", "", "ä", "a", "ì", "i", "ù", "u")
+	word = fold.Replace(strings.ToLower(strings.TrimSpace(word)))
+	return fold.Replace(dialectCrunch([]string{word}, false, false, true)[0])
+}
+
+// The prefix has to have the same phonemes as the word, except the last one can be the start of a longer one.
+// The last one could also be two letters that are really two phonemes, like the ay in ayoe
+func autocompletePhonemesMatch(prefix []string, word []string) bool {
+	match := func(prefix []string) bool {
+		if len(prefix) > len(word) {
+			return false
+		}
+		last := len(prefix) - 1
+		return slices.Equal(prefix[:last], word[:last]) && strings.HasPrefix(word[last], prefix[last])
+	}
+	if match(prefix) {
+		return true
+	}
+	last := prefix[len(prefix)-1]
+	if len(last) == 2 && isNucleusPhoneme(last) {
+		return match(append(slices.Clone(prefix[:len(prefix)-1]), last[:1], last[1:]))
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package fwew_lib
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAutocomplete(t *testing.T) {
+	CacheDictHash()
+	CacheDictHash2()
+	tests := []struct {
+		prefix, lang string
+		want         []string
+	}{
+		{"tsmuk", "navi", []string{"tsmuk", "tsmuke", "tsmukan"}},
+		{"TA", "", []string{"taw", "taron", "taronyu", "taronyutsyìp"}},
+		{"eyl", "", []string{"'eylan"}}, // no tìftang
+		{"sil", "", []string{"sìlpey", "sìltsan"}},
+		{"dep", "", []string{"txep"}}, // reef
+		{"irayo s", "", []string{"irayo si"}},
+		{"ng", "", []string{"nga", "ngay", "ngim", "ngeyä"}},
+		{"b", "de", []string{"banshee", "baum", "berühren"}},
+		{"qq", "", nil},
+	}
+	for _, tt := range tests {
+		got := Autocomplete(tt.prefix, tt.lang, len(tt.want))
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Autocomplete(%q, %q) = %v, want %v", tt.prefix, tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestAutocompletePhonemesMatch(t *testing.T) {
+	tests := []struct {
+		prefix, word string
+		want         bool
+	}{
+		{"k", "kxetse", true},
+		{"kx", "kelku", false},
+		{"nga", "nantang", false},
+		{"ay", "ayoe", true},
+		{"tsm", "tsmukan", true},
+	}
+	for _, tt := range tests {
+		if got := autocompletePhonemesMatch(splitPhonemes(tt.prefix), splitPhonemes(tt.word)); got != tt.want {
+			t.Errorf("autocompletePhonemesMatch(%q, %q) = %v, want %v", tt.prefix, tt.word, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package fwew_lib
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+)
+
+type BatchOptions struct {
+	CheckFixes bool
+	Strict     bool
+	AllowReef  bool
+	Workers    int // how many lookups at once.  0 means one per CPU
+}
+
+// What TranslateFromNaviHash would give for one input.  Err is the first error from any of its words
+type BatchResult struct {
+	Results [][]Word
+	Err     error
+}
+
+// Translate a lot of Na'vi lines at once, like subtitles.  Every different word is only looked up once,
+// and the lookups run at the same time.  The results are in the same order as the inputs
+func TranslateBatch(inputs []string, options BatchOptions) []BatchResult {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	if options.Workers <= 0 {
+		options.Workers = runtime.NumCPU()
+	}
+
+	dict := &dictHashLoose
+	if !options.AllowReef {
+		dict = &dictHashStrict
+	} else if options.Strict {
+		dict = &dictHashStrictReef
+	}
+
+	// Clean every line once and find the different words in them
+	lines := make([][]string, len(inputs))
+	words := map[string]*batchWord{}
+	for i, a := range inputs {
+		a = clean(a)
+		if len(a) == 0 {
+			continue
+		}
+		lines[i] = strings.Split(a, " ")
+		for _, b := range lines[i] {
+			if words[b] == nil {
+				words[b] = &batchWord{}
+			}
+		}
+	}
+
+	pool := newBatchPool(options.Workers)
+	for word, found := range words {
+		pool.run(func() {
+			// Skip ridiculously long words, like TranslateFromNaviHash does
+			if len([]rune(word)) > 50 {
+				return
+			}
+			_, found.results, found.err = TranslateFromNaviHashHelper(dict, 0, []string{word}, options.CheckFixes, options.Strict, options.AllowReef)
+			found.multiword = batchStartsMultiword(word, found.results)
+		})
+	}
+	pool.wait()
+
+	// Lines with a word that could start a multiword word (like irayo si) depend on the words after it,
+	// so they're done the usual way.  The rest are put together from the words
+	results := make([]BatchResult, len(inputs))
+	for i, line := range lines {
+		if line == nil {
+			continue
+		}
+
+		multiword := false
+		for _, a := range line {
+			multiword = multiword || words[a].multiword
+		}
+		if multiword {
+			pool.run(func() {
+				results[i].Results, results[i].Err = translateFromNaviHash(inputs[i], options.CheckFixes, options.Strict, options.AllowReef)
+			})
+			continue
+		}
+
+		results[i].Results = [][]Word{}
+		for _, a := range line {
+			found := words[a]
+			if found.err != nil && results[i].Err == nil {
+				results[i].Err = found.err
+			}
+			for _, b := range found.results {
+				results[i].Results = append(results[i].Results, append([]Word{}, b...))
+			}
+		}
+	}
+	pool.wait()
+
+	return results
+}
+
+type batchWord struct {
+	results   [][]Word
+	err       error
+	multiword bool
+}
+
+// If a word could be the start of a multiword word, as it is or deconjugated
+func batchStartsMultiword(word string, results [][]Word) bool {
+	starts := func(a string) bool {
+		_, ok1 := multiword_words[a]
+		_, ok2 := multiword_words_loose[a]
+		_, ok3 := multiword_words_reef[a]
+		return ok1 || ok2 || ok3
+	}
+
+	if starts(word) || starts(dialectCrunch([]string{word}, false, false, true)[0]) {
+		return true
+	}
+	for _, a := range results {
+		for _, b := range a[1:] {
+			if starts(strings.ToLower(b.Navi)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// A bounded worker pool
+type batchPool struct {
+	slots chan struct{}
+	group sync.WaitGroup
+}
+
+func newBatchPool(workers int) *batchPool {
+	return &batchPool{slots: make(chan struct{}, workers)}
+}
+
+func (p *batchPool) run(f func()) {
+	p.group.Add(1)
+	p.slots <- struct{}{}
+	go func() {
+		defer func() {
+			<-p.slots
+			p.group.Done()
+		}()
+		f()
+	}()
+}
+
+func (p *batchPool) wait() {
+	p.group.Wait()
+}
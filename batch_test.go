@@ -0,0 +1,36 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTranslateBatch(t *testing.T) {
+	CacheDictHash()
+	PhonemeDistros()
+	inputs := []string{
+		"Oel ngati kameie",
+		"Irayo si, ma tsmukan!",
+		"",
+		"fìtutet tarmaron",
+		"Oel ngati kameie",
+		"txopu soli nìwotx",
+	}
+	for i := 0; i < 30; i++ {
+		inputs = append(inputs, inputs[i%6])
+	}
+
+	got := TranslateBatch(inputs, BatchOptions{CheckFixes: true, AllowReef: true, Workers: 4})
+	if len(got) != len(inputs) {
+		t.Fatalf("TranslateBatch() = %d results, want %d", len(got), len(inputs))
+	}
+	for i, a := range inputs {
+		want, err := TranslateFromNaviHash(a, true, false, true)
+		if !reflect.DeepEqual(got[i].Results, want) || got[i].Err != err {
+			t.Errorf("TranslateBatch() %d (%q) = %v, %v, want %v, %v", i, a, got[i].Results, got[i].Err, want, err)
+		}
+	}
+	if got[1].Results[0][1].Navi != "irayo si" {
+		t.Errorf("TranslateBatch() %q = %v, want irayo si first", inputs[1], got[1].Results[0])
+	}
+}
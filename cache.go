@@ -25,6 +25,7 @@ var dictionaryCached bool
 var dictHashCached bool
 var dictHash2 MetaDict
 var dictHash2Parenthesis MetaDict
+var dictHash2Normalized MetaDict
 var dictHash2Cached bool
 var homonyms string
 var oddballs string
@@ -48,18 +49,6 @@ type MetaDict struct {
 	UK map[string][]string
 }
 
-var letterMap = map[rune]int{
-	' ': -1, '\'': 0, 'a': 1, '2': 2, '3': 3,
-	'ä': 4, 'e': 5, '4': 6, '5': 7,
-	'f': 8, 'h': 9, 'i': 10, 'ì': 11,
-	'j': 12, 'k': 13, 'q': 14, 'l': 15,
-	'1': 16, 'm': 17, 'n': 18, 'g': 19,
-	'o': 20, 'p': 21, 'b': 22, 'r': 23,
-	'0': 24, 's': 25, 't': 26, 'c': 27,
-	'd': 28, 'u': 29, 'v': 30, 'w': 31,
-	'y': 32, 'z': 33, '-': 34,
-}
-
 var nkx = []string{}
 var nkxSub = map[string]string{}
 
@@ -69,6 +58,23 @@ var nkxSub = map[string]string{}
 var universalLock sync.Mutex
 var phonoLock sync.Mutex
 
+// Indexes made from the cached dictionaries.  Each one is made the first time it's used
+// and thrown away whenever a dictionary is uncached
+var derivedIndexes = map[string]any{}
+
+// Get a derived index by name, making it with build if it isn't there.  build says whether to keep
+// the index, so one made before the dictionaries are cached doesn't stick around
+func derivedIndex[T any](name string, build func() (T, bool)) T {
+	if index, ok := derivedIndexes[name]; ok {
+		return index.(T)
+	}
+	index, keep := build()
+	if keep {
+		derivedIndexes[name] = index
+	}
+	return index
+}
+
 // helper for nkx for shortest words first
 func shortestFirst(array []string, input string) []string {
 	newArray := []string{}
@@ -122,28 +128,9 @@ func FindDictionaryFile() string {
 	return ""
 }
 
+// Does a go before b?  See Collator
 func AlphabetizeHelper(a string, b string) bool {
-	aCompacted := []rune(strings.ReplaceAll(compress(strings.ToLower(a)), "-", ""))
-
-	// Start in the middle
-	bCompacted := []rune(strings.ReplaceAll(compress(strings.ToLower(b)), "-", ""))
-	lowestLen := len(aCompacted)
-	if lowestLen > len(bCompacted) {
-		lowestLen = len(bCompacted)
-	}
-	// compare an individual word
-	for j := 0; j < lowestLen; j++ {
-		// If the new letter is bigger, wait until it gets
-		if letterMap[aCompacted[j]] < letterMap[bCompacted[j]] {
-			return true
-		} else if letterMap[aCompacted[j]] > letterMap[bCompacted[j]] {
-			return false
-		}
-		// If equal, continue
-	}
-
-	// longer words go after
-	return len(aCompacted) < len(bCompacted)
+	return defaultCollator.Compare(a, b) < 0
 }
 
 func AppendAndAlphabetize(words []Word, word Word) []Word {
@@ -589,9 +576,10 @@ func CacheDictHashOrig(mysql bool) error {
 
 		// See whether or not it violates normal phonotactic rules like Jakesully or Oìsss
 		valid := true
-		for _, a := range strings.Split(IsValidNavi(word.Navi, "en", false), "\n") {
+		for _, a := range strings.Split(word.Navi, " ") {
 			// Check every word.  If one of them isn't good, write down the word
-			if len(a) > 0 && (!strings.Contains(a, "Valid:") || strings.Contains(a, "reef")) {
+			result := ValidateNavi(a)
+			if result.Verdict != VerdictValid || result.ReefOnly {
 				valid = false
 				break
 			}
@@ -741,6 +729,22 @@ func CacheDictHash2Orig(mysql bool) error {
 		dictHash2Parenthesis.SV = make(map[string][]string)
 		dictHash2Parenthesis.TR = make(map[string][]string)
 		dictHash2Parenthesis.UK = make(map[string][]string)
+
+		dictHash2Normalized.EN = make(map[string][]string)
+		dictHash2Normalized.DE = make(map[string][]string)
+		dictHash2Normalized.ES = make(map[string][]string)
+		dictHash2Normalized.ET = make(map[string][]string)
+		dictHash2Normalized.FR = make(map[string][]string)
+		dictHash2Normalized.HU = make(map[string][]string)
+		dictHash2Normalized.IT = make(map[string][]string)
+		dictHash2Normalized.KO = make(map[string][]string)
+		dictHash2Normalized.NL = make(map[string][]string)
+		dictHash2Normalized.PL = make(map[string][]string)
+		dictHash2Normalized.PT = make(map[string][]string)
+		dictHash2Normalized.RU = make(map[string][]string)
+		dictHash2Normalized.SV = make(map[string][]string)
+		dictHash2Normalized.TR = make(map[string][]string)
+		dictHash2Normalized.UK = make(map[string][]string)
 	}
 
 	// Set up the whole thing
@@ -753,90 +757,105 @@ func CacheDictHash2Orig(mysql bool) error {
 		if !NullDef(word.EN) {
 			dictHash2.EN = AssignWord(dictHash2.EN, word.EN, standardizedWord, true)
 			dictHash2Parenthesis.EN = AssignWord(dictHash2Parenthesis.EN, word.EN, standardizedWord, false)
+			dictHash2Normalized.EN = AssignNormalizedWord(dictHash2Normalized.EN, word.EN, standardizedWord, "en")
 		}
 
 		// German (Deutsch)
 		if !NullDef(word.DE) {
 			dictHash2.DE = AssignWord(dictHash2.DE, word.DE, standardizedWord, true)
 			dictHash2Parenthesis.DE = AssignWord(dictHash2Parenthesis.DE, word.DE, standardizedWord, false)
+			dictHash2Normalized.DE = AssignNormalizedWord(dictHash2Normalized.DE, word.DE, standardizedWord, "de")
 		}
 
 		// Spanish (Español)
 		if !NullDef(word.ES) {
 			dictHash2.ES = AssignWord(dictHash2.ES, word.ES, standardizedWord, true)
 			dictHash2Parenthesis.ES = AssignWord(dictHash2Parenthesis.ES, word.ES, standardizedWord, false)
+			dictHash2Normalized.ES = AssignNormalizedWord(dictHash2Normalized.ES, word.ES, standardizedWord, "es")
 		}
 
 		// Estonian (Eesti)
 		if !NullDef(word.ET) {
 			dictHash2.ET = AssignWord(dictHash2.ET, word.ET, standardizedWord, true)
 			dictHash2Parenthesis.ET = AssignWord(dictHash2Parenthesis.ET, word.ET, standardizedWord, false)
+			dictHash2Normalized.ET = AssignNormalizedWord(dictHash2Normalized.ET, word.ET, standardizedWord, "et")
 		}
 
 		// French (Français)
 		if !NullDef(word.FR) {
 			dictHash2.FR = AssignWord(dictHash2.FR, word.FR, standardizedWord, true)
 			dictHash2Parenthesis.FR = AssignWord(dictHash2Parenthesis.FR, word.FR, standardizedWord, false)
+			dictHash2Normalized.FR = AssignNormalizedWord(dictHash2Normalized.FR, word.FR, standardizedWord, "fr")
 		}
 
 		// Hungarian (Magyar)
 		if !NullDef(word.HU) {
 			dictHash2.HU = AssignWord(dictHash2.HU, word.HU, standardizedWord, true)
 			dictHash2Parenthesis.HU = AssignWord(dictHash2Parenthesis.HU, word.HU, standardizedWord, false)
+			dictHash2Normalized.HU = AssignNormalizedWord(dictHash2Normalized.HU, word.HU, standardizedWord, "hu")
 		}
 
 		// Italian (Italiano)
 		if !NullDef(word.IT) {
 			dictHash2.IT = AssignWord(dictHash2.IT, word.IT, standardizedWord, true)
 			dictHash2Parenthesis.IT = AssignWord(dictHash2Parenthesis.IT, word.IT, standardizedWord, false)
+			dictHash2Normalized.IT = AssignNormalizedWord(dictHash2Normalized.IT, word.IT, standardizedWord, "it")
 		}
 
 		// Korean (한국어)
 		if !NullDef(word.KO) {
 			dictHash2.KO = AssignWord(dictHash2.KO, word.KO, standardizedWord, true)
 			dictHash2Parenthesis.KO = AssignWord(dictHash2Parenthesis.KO, word.KO, standardizedWord, false)
+			dictHash2Normalized.KO = AssignNormalizedWord(dictHash2Normalized.KO, word.KO, standardizedWord, "ko")
 		}
 
 		// Dutch (Nederlands)
 		if !NullDef(word.NL) {
 			dictHash2.NL = AssignWord(dictHash2.NL, word.NL, standardizedWord, true)
 			dictHash2Parenthesis.NL = AssignWord(dictHash2Parenthesis.NL, word.NL, standardizedWord, false)
+			dictHash2Normalized.NL = AssignNormalizedWord(dictHash2Normalized.NL, word.NL, standardizedWord, "nl")
 		}
 
 		// Polish (Polski)
 		if !NullDef(word.PL) {
 			dictHash2.PL = AssignWord(dictHash2.PL, word.PL, standardizedWord, true)
 			dictHash2Parenthesis.PL = AssignWord(dictHash2Parenthesis.PL, word.PL, standardizedWord, false)
+			dictHash2Normalized.PL = AssignNormalizedWord(dictHash2Normalized.PL, word.PL, standardizedWord, "pl")
 		}
 
 		// Portuguese (Português)
 		if !NullDef(word.PT) {
 			dictHash2.PT = AssignWord(dictHash2.PT, word.PT, standardizedWord, true)
 			dictHash2Parenthesis.PT = AssignWord(dictHash2Parenthesis.PT, word.PT, standardizedWord, false)
+			dictHash2Normalized.PT = AssignNormalizedWord(dictHash2Normalized.PT, word.PT, standardizedWord, "pt")
 		}
 
 		// Russian (Русский)
 		if !NullDef(word.RU) {
 			dictHash2.RU = AssignWord(dictHash2.RU, word.RU, standardizedWord, true)
 			dictHash2Parenthesis.RU = AssignWord(dictHash2Parenthesis.RU, word.RU, standardizedWord, false)
+			dictHash2Normalized.RU = AssignNormalizedWord(dictHash2Normalized.RU, word.RU, standardizedWord, "ru")
 		}
 
 		// Swedish (Svenska)
 		if !NullDef(word.SV) {
 			dictHash2.SV = AssignWord(dictHash2.SV, word.SV, standardizedWord, true)
 			dictHash2Parenthesis.SV = AssignWord(dictHash2Parenthesis.SV, word.SV, standardizedWord, false)
+			dictHash2Normalized.SV = AssignNormalizedWord(dictHash2Normalized.SV, word.SV, standardizedWord, "sv")
 		}
 
 		// Turkish (Türkçe)
 		if !NullDef(word.TR) {
 			dictHash2.TR = AssignWord(dictHash2.TR, word.TR, standardizedWord, true)
 			dictHash2Parenthesis.TR = AssignWord(dictHash2Parenthesis.TR, word.TR, standardizedWord, false)
+			dictHash2Normalized.TR = AssignNormalizedWord(dictHash2Normalized.TR, word.TR, standardizedWord, "tr")
 		}
 
 		// Ukrainian (Українська)
 		if !NullDef(word.UK) {
 			dictHash2.UK = AssignWord(dictHash2.UK, word.UK, standardizedWord, true)
 			dictHash2Parenthesis.UK = AssignWord(dictHash2Parenthesis.UK, word.UK, standardizedWord, false)
+			dictHash2Normalized.UK = AssignNormalizedWord(dictHash2Normalized.UK, word.UK, standardizedWord, "uk")
 		}
 		return nil
 	}
@@ -866,12 +885,14 @@ func UncacheHashDict() {
 	dictHashCached = false
 	dictHashLoose = nil
 	dictHashStrict = nil
+	clear(derivedIndexes)
 	homonyms = ""
 	oddballs = ""
 }
 
 func UncacheHashDict2() {
 	dictHash2Cached = false
+	clear(derivedIndexes)
 	dictHash2.EN = nil
 	dictHash2.DE = nil
 	dictHash2.ES = nil
@@ -903,6 +924,22 @@ func UncacheHashDict2() {
 	dictHash2Parenthesis.SV = nil
 	dictHash2Parenthesis.TR = nil
 	dictHash2Parenthesis.UK = nil
+
+	dictHash2Normalized.EN = nil
+	dictHash2Normalized.DE = nil
+	dictHash2Normalized.ES = nil
+	dictHash2Normalized.ET = nil
+	dictHash2Normalized.FR = nil
+	dictHash2Normalized.HU = nil
+	dictHash2Normalized.IT = nil
+	dictHash2Normalized.KO = nil
+	dictHash2Normalized.NL = nil
+	dictHash2Normalized.PL = nil
+	dictHash2Normalized.PT = nil
+	dictHash2Normalized.RU = nil
+	dictHash2Normalized.SV = nil
+	dictHash2Normalized.TR = nil
+	dictHash2Normalized.UK = nil
 }
 
 // This will run the function `f` inside the cache or the file directly.
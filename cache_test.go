@@ -91,3 +91,25 @@ func Test_cacheDict(t *testing.T) {
 			word.InfixDots, entry[0].InfixDots)
 	}
 }
+
+func TestDerivedIndexBeforeCache(t *testing.T) {
+	UncacheHashDict()
+	if _, err := SearchDefinitions("hunting", "en", 0); err != NoResults {
+		t.Errorf("SearchDefinitions() error = %v, want %v", err, NoResults)
+	}
+	if len(derivedIndexes) != 0 {
+		t.Errorf("derivedIndexes kept %d indexes made before caching", len(derivedIndexes))
+	}
+	CacheDictHashOrig(false) // CacheDictHash uncaches everything when there's no SQL
+	if got, err := SearchDefinitions("hunting", "en", 1); err != nil || len(got) != 1 || got[0].Word.Navi != "tìtaron" {
+		t.Errorf("SearchDefinitions() after caching = %v, %v, want tìtaron", got, err)
+	}
+	if len(derivedIndexes) == 0 {
+		t.Errorf("derivedIndexes didn't keep the index made after caching")
+	}
+	UncacheHashDict()
+	if len(derivedIndexes) != 0 {
+		t.Errorf("UncacheHashDict() left %d derived indexes", len(derivedIndexes))
+	}
+	CacheDictHashOrig(false)
+}
@@ -0,0 +1,109 @@
+package fwew_lib
+
+import (
+	"slices"
+	"strings"
+)
+
+// Na'vi alphabetical order.  Digraphs are letters of their own, and so are ä, ì and ù.
+// The reef letters go where they would in the Latin alphabet, unless CollatorOptions.Reef is set
+var collationOrder = []string{
+	" ", "'", "a", "aw", "ay", "ä", "b", "ch", "d", "e", "ew", "ey", "f", "g", "h", "i", "ì",
+	"j", "k", "kx", "l", "ll", "m", "n", "ng", "o", "p", "px", "r", "rr", "s", "sh",
+	"t", "ts", "tx", "u", "ù", "v", "w", "y", "z",
+}
+
+var collationIndex = func() map[string]int {
+	index := map[string]int{}
+	for i, a := range collationOrder {
+		index[a] = i
+	}
+	return index
+}()
+
+// Reef spellings sorted as the forest ones
+var collationReef = map[string][]string{
+	"b": {"px"}, "d": {"tx"}, "g": {"kx"}, "ch": {"ts", "y"}, "sh": {"s", "y"},
+}
+
+type CollatorOptions struct {
+	Reef          bool // sort b, d, g, ch and sh with px, tx, kx, tsy and sy
+	IgnoreTiftang bool // sort 'eylan with eylan
+}
+
+// Sorts things the same way the rest of the library does
+type Collator struct {
+	options CollatorOptions
+}
+
+var defaultCollator = NewCollator(CollatorOptions{})
+
+func NewCollator(options CollatorOptions) *Collator {
+	return &Collator{options}
+}
+
+// -1 if a goes first, 1 if b goes first, 0 if they're the same.
+// Dashes are syllable breaks, so "n-g" is two letters, not ng
+func (c *Collator) Compare(a, b string) int {
+	return slices.Compare(c.key(a), c.key(b))
+}
+
+// Sort in place
+func (c *Collator) SortStrings(s []string) {
+	slices.SortStableFunc(s, c.Compare)
+}
+
+// Sort in place by the syllable breakdown (or the word itself if there isn't one)
+func (c *Collator) SortWords(words []Word) {
+	slices.SortStableFunc(words, func(a, b Word) int {
+		return c.Compare(collationSource(a), collationSource(b))
+	})
+}
+
+func collationSource(word Word) string {
+	if len(word.Syllables) > 0 {
+		return word.Syllables
+	}
+	return word.Navi
+}
+
+// Every letter's place in collationOrder.  Anything not in it goes after z
+func (c *Collator) key(s string) (key []int) {
+	s = strings.ToLower(s)
+	s = strings.ReplaceAll(s, "’", "'")
+	s = strings.ReplaceAll(s, "‘", "'")
+	runes := []rune(s)
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '-' {
+			continue
+		}
+		letter := string(runes[i])
+		if i+1 < len(runes) {
+			if _, ok := collationIndex[string(runes[i:i+2])]; ok {
+				letter = string(runes[i : i+2])
+				i++
+			}
+		}
+
+		letters := []string{letter}
+		if c.options.Reef {
+			if forest, ok := collationReef[letter]; ok {
+				letters = forest
+			}
+		}
+
+		for _, a := range letters {
+			if a == "'" && c.options.IgnoreTiftang {
+				continue
+			}
+			if index, ok := collationIndex[a]; ok {
+				key = append(key, index)
+			} else {
+				key = append(key, len(collationOrder)+int(runes[i]))
+			}
+		}
+	}
+
+	return
+}
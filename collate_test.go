@@ -0,0 +1,54 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCollatorCompare(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		options CollatorOptions
+		want    int
+	}{
+		{"kaltxì", "kaltxì", CollatorOptions{}, 0},
+		{"kelku", "kxetse", CollatorOptions{}, -1}, // kx is its own letter after k
+		{"tsmukan", "txep", CollatorOptions{}, -1}, // ts before tx
+		{"tute", "tsmuke", CollatorOptions{}, -1},  // t before ts
+		{"nga", "nawm", CollatorOptions{}, 1},      // ng after n
+		{"ayoe", "äo", CollatorOptions{}, -1},
+		{"ean", "ewan", CollatorOptions{}, -1},
+		{"tsmuk", "tsmùk", CollatorOptions{}, -1},
+		{"ka-nga", "kan-ga", CollatorOptions{}, 1}, // kan-ga is n then g, not ng
+		{"'eylan", "eylan", CollatorOptions{}, -1},
+		{"'eylan", "eylan", CollatorOptions{IgnoreTiftang: true}, 0},
+		{"adge", "apxa", CollatorOptions{}, -1},
+		{"adge", "atxkxe", CollatorOptions{Reef: true}, 0},
+		{"chal", "tsyal", CollatorOptions{Reef: true}, 0},
+		{"irayo si", "irayosi", CollatorOptions{}, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			if got := NewCollator(tt.options).Compare(tt.a, tt.b); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollatorSort(t *testing.T) {
+	collator := NewCollator(CollatorOptions{})
+
+	list := []string{"tsmuk", "txep", "tute", "'eylan", "ayoe", "kxetse", "kelku", "äo", "ean"}
+	collator.SortStrings(list)
+	want := []string{"'eylan", "ayoe", "äo", "ean", "kelku", "kxetse", "tute", "tsmuk", "txep"}
+	if !reflect.DeepEqual(list, want) {
+		t.Errorf("SortStrings() = %v, want %v", list, want)
+	}
+
+	words := []Word{{Navi: "txep", Syllables: "txep"}, {Navi: "tsmukan", Syllables: "tsmu-kan"}, {Navi: "taron"}}
+	collator.SortWords(words)
+	if words[0].Navi != "taron" || words[1].Navi != "tsmukan" || words[2].Navi != "txep" {
+		t.Errorf("SortWords() = %v", words)
+	}
+}
@@ -0,0 +1,207 @@
+package fwew_lib
+
+import (
+	"slices"
+	"strings"
+)
+
+// One of the words a compound is made of
+type CompoundPart struct {
+	Form    string `json:"form"`    // how it's written in the compound
+	Word    Word   `json:"word"`    // the dictionary word.  A lenited part has it in Word.Affixes.Lenition
+	Clipped bool   `json:"clipped"` // the end was left off, like kel for kelku in kelutral
+	Gloss   string `json:"gloss"`
+}
+
+// One way to split a word into dictionary words.  These are guesses, so they're less sure than
+// anything TranslateFromNaviHash finds
+type CompoundSplit struct {
+	Parts []CompoundPart `json:"parts"`
+	Gloss string         `json:"gloss"` // the part glosses joined with +
+}
+
+const (
+	maxCompoundParts = 4
+	minCompoundPart  = 2 // runes
+)
+
+// The ways a word could be dictionary words stuck together, best first.  Every part after the first can be lenited,
+// every part before the last can be clipped to its first syllables and the last one can have affixes.
+// A + marks where the parts meet if they're known, like tsko+swizaw
+func SplitCompound(word string, langCode string) (splits []CompoundSplit, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+	return splitCompound(word, langCode)
+}
+
+func splitCompound(word string, langCode string) (splits []CompoundSplit, err error) {
+	// Where the + were
+	runes := []rune{}
+	boundaries := []int{}
+	for _, a := range strings.Split(word, "+") {
+		a = strings.ToLower(clean(a))
+		if len(a) == 0 || strings.Contains(a, " ") {
+			return nil, NoResults
+		}
+		runes = append(runes, []rune(a)...)
+		boundaries = append(boundaries, len(runes))
+	}
+
+	// Every way to split the rest of the word, starting at each rune
+	memo := map[int][][]CompoundPart{}
+	var split func(start int) [][]CompoundPart
+	split = func(start int) [][]CompoundPart {
+		if found, ok := memo[start]; ok {
+			return found
+		}
+		found := [][]CompoundPart{}
+		end := boundaries[slices.IndexFunc(boundaries, func(a int) bool { return a > start })]
+		for i := start + minCompoundPart; i <= end; i++ {
+			form := string(runes[start:i])
+			if i == len(runes) {
+				for _, a := range compoundLastParts(form, start > 0) {
+					found = append(found, []CompoundPart{a})
+				}
+				continue
+			}
+			rest := split(i)
+			if len(rest) == 0 {
+				continue
+			}
+			for _, a := range compoundParts(form, start > 0) {
+				for _, b := range rest {
+					if len(b) < maxCompoundParts {
+						found = append(found, append([]CompoundPart{a}, b...))
+					}
+				}
+			}
+		}
+		memo[start] = found
+		return found
+	}
+
+	whole := string(runes)
+	for _, parts := range split(0) {
+		if len(parts) < 2 && len(boundaries) < 2 {
+			continue // that's just a word
+		}
+		if slices.ContainsFunc(parts, func(a CompoundPart) bool { return strings.ToLower(a.Word.Navi) == whole }) {
+			continue // kelutral isn't kel(utral) and utral
+		}
+		glosses := []string{}
+		for i := range parts {
+			parts[i].Gloss = glossDefinition(parts[i].Word, langCode)
+			glosses = append(glosses, parts[i].Gloss)
+		}
+		splits = append(splits, CompoundSplit{Parts: parts, Gloss: strings.Join(glosses, "+")})
+	}
+
+	// Fewer parts and fewer changes first
+	slices.SortStableFunc(splits, func(a, b CompoundSplit) int {
+		if len(a.Parts) != len(b.Parts) {
+			return len(a.Parts) - len(b.Parts)
+		}
+		if compoundChanges(a) != compoundChanges(b) {
+			return compoundChanges(a) - compoundChanges(b)
+		}
+		return len([]rune(b.Parts[0].Form)) - len([]rune(a.Parts[0].Form))
+	})
+
+	if len(splits) == 0 {
+		err = NoResults
+	}
+	return
+}
+
+// The first syllables of every word with more than one syllable, made the first time they're used.
+// Parts before the last one can be clipped like this
+func compoundClips() map[string][]Word {
+	return derivedIndex("compound clips", func() (map[string][]Word, bool) {
+		return newCompoundClips(), len(dictHashStrict) > 0
+	})
+}
+
+func newCompoundClips() map[string][]Word {
+	compoundClips := map[string][]Word{}
+	for _, words := range dictHashStrict {
+		for _, word := range words {
+			if strings.Contains(word.Navi, " ") {
+				continue
+			}
+			syllables := strings.Split(strings.ToLower(strings.Split(word.Syllables, " or ")[0]), "-")
+			for i := 1; i < len(syllables); i++ {
+				clip := strings.Join(syllables[:i], "")
+				if len([]rune(clip)) >= minCompoundPart {
+					compoundClips[clip] = append(compoundClips[clip], word)
+				}
+			}
+		}
+	}
+	return compoundClips
+}
+
+// What a part that isn't the last one could be
+func compoundParts(form string, lenited bool) (parts []CompoundPart) {
+	for _, a := range compoundUnlenite(form, lenited) {
+		for _, b := range dictHashStrict[a[0]] {
+			parts = append(parts, compoundPart(form, b, a[1], false))
+		}
+		for _, b := range compoundClips()[a[0]] {
+			parts = append(parts, compoundPart(form, b, a[1], true))
+		}
+	}
+	return
+}
+
+// The last part can also have affixes
+func compoundLastParts(form string, lenited bool) (parts []CompoundPart) {
+	for _, a := range compoundUnlenite(form, lenited) {
+		for _, b := range dictHashStrict[a[0]] {
+			parts = append(parts, compoundPart(form, b, a[1], false))
+		}
+		for _, b := range TestDeconjugations(&dictHashStrict, a[0], true, false, false) {
+			if len(b.Affixes.Lenition) > 0 {
+				continue // compoundUnlenite already does it
+			}
+			parts = append(parts, compoundPart(form, b, a[1], false))
+		}
+	}
+	return
+}
+
+func compoundPart(form string, word Word, lenition string, clipped bool) CompoundPart {
+	if len(lenition) > 0 {
+		word.Affixes.Lenition = append(slices.Clone(word.Affixes.Lenition), lenition)
+	}
+	return CompoundPart{Form: form, Word: word, Clipped: clipped}
+}
+
+// The form, and what it could be if it was lenited, with the lenition like "t→s"
+func compoundUnlenite(form string, lenited bool) (forms [][2]string) {
+	forms = [][2]string{{form, ""}}
+	if !lenited {
+		return
+	}
+	for _, oldPrefix := range unlenitionLetters {
+		if strings.HasPrefix(form, oldPrefix) {
+			for _, newPrefix := range unlenition[oldPrefix] {
+				if newPrefix != oldPrefix {
+					forms = append(forms, [2]string{newPrefix + strings.TrimPrefix(form, oldPrefix), newPrefix + "→" + oldPrefix})
+				}
+			}
+			break // We don't want the "ts" to become "txs"
+		}
+	}
+	return
+}
+
+// How far the parts are from plain dictionary words
+func compoundChanges(split CompoundSplit) (changes int) {
+	for _, a := range split.Parts {
+		if a.Clipped {
+			changes++
+		}
+		changes += len(a.Word.Affixes.Prefix) + len(a.Word.Affixes.Infix) + len(a.Word.Affixes.Suffix) + len(a.Word.Affixes.Lenition)
+	}
+	return
+}
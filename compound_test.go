@@ -0,0 +1,67 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitCompound(t *testing.T) {
+	CacheDictHash()
+	tests := []struct {
+		word      string
+		wantParts []string // Form=Navi of the best split
+		wantGloss string
+	}{
+		{"tskoswizaw", []string{"tsko=tsko", "swizaw=swizaw"}, "bow+arrow"},
+		{"tsko+swizaw", []string{"tsko=tsko", "swizaw=swizaw"}, "bow+arrow"},
+		{"kelutral", []string{"kel=kelku", "utral=utral"}, "home+tree"},         // clipped
+		{"txonsute", []string{"txon=txon", "sute=tute"}, "night+person"},        // lenited
+		{"tskoswizawit", []string{"tsko=tsko", "swizawit=swizaw"}, "bow+arrow"}, // with a suffix
+		{"ikransawtute", []string{"ikran=ikran", "sawtute=sawtute"}, "banshee+sky.person"},
+		{"tute", nil, ""},
+		{"utralsì", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			splits, err := SplitCompound(tt.word, "en")
+			if tt.wantParts == nil {
+				if err != NoResults {
+					t.Errorf("SplitCompound() = %v, want %v", splits, NoResults)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SplitCompound() error = %v", err)
+			}
+			var got []string
+			for _, a := range splits[0].Parts {
+				got = append(got, a.Form+"="+a.Word.Navi)
+			}
+			if !reflect.DeepEqual(got, tt.wantParts) || splits[0].Gloss != tt.wantGloss {
+				t.Errorf("SplitCompound() = %v %q, want %v %q", got, splits[0].Gloss, tt.wantParts, tt.wantGloss)
+			}
+		})
+	}
+
+	splits, _ := SplitCompound("txonsute", "de")
+	if a := splits[0].Parts[1]; a.Clipped || !reflect.DeepEqual(a.Word.Affixes.Lenition, []string{"t→s"}) || a.Gloss != "Person" {
+		t.Errorf("SplitCompound() sute = %+v", a)
+	}
+	splits, _ = SplitCompound("kelutral", "en")
+	if !splits[0].Parts[0].Clipped || splits[0].Parts[1].Clipped {
+		t.Errorf("SplitCompound() kelutral clipped = %v %v", splits[0].Parts[0].Clipped, splits[0].Parts[1].Clipped)
+	}
+}
+
+func TestSearchNaviCompound(t *testing.T) {
+	CacheDictHash()
+	got, err := SearchNavi("tskoswizaw", true, false, true)
+	if err != nil || len(got) != 1 || got[0].Kind != MatchCompound || len(got[0].Compounds) == 0 || len(got[0].Words) != 2 {
+		t.Errorf("SearchNavi() = %+v, %v, want a compound", got, err)
+	}
+
+	got, err = SearchNaviWith("txonsute", SearchOptions{CheckFixes: true, Lang: "de"})
+	if err != nil || len(got) != 1 || len(got[0].Compounds) == 0 || got[0].Compounds[0].Parts[1].Gloss != "Person" {
+		t.Errorf("SearchNaviWith() = %+v, %v, want the glosses in German", got, err)
+	}
+}
@@ -0,0 +1,203 @@
+package fwew_lib
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Text rewritten into another dialect
+type DialectConversion struct {
+	Text        string
+	Ambiguities []DialectAmbiguity
+}
+
+// A word that could be more than one thing in the other dialect.
+// Text has the first option, and Offset is where it is in Text (in bytes)
+type DialectAmbiguity struct {
+	Original string
+	Offset   int
+	Options  []string
+}
+
+// Letters and tìftangs
+var dialectWordRegex = regexp.MustCompile(`[\p{L}'’‘]+`)
+
+// Don't try more than 2^8 spellings of one word
+const maxDialectChoices = 8
+
+// Rewrite Na'vi text from one dialect to another, leaving the spaces and punctuation alone.
+// Going to reef drops tìftangs between unlike vowels, voices ejectives, turns tsy and sy into ch and sh,
+// and makes unstressed ä into e.  Going back to forest can't always know what was there,
+// so every spelling the dictionary knows about is given as an Ambiguity.  DialectBoth is interdialect
+func ConvertDialect(text string, from Dialect, to Dialect) (result DialectConversion, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	if from < DialectBoth || from > DialectReef || to < DialectBoth || to > DialectReef {
+		return result, InvalidDialect
+	}
+	if from == to {
+		result.Text = text
+		return
+	}
+
+	last := 0
+	for _, loc := range dialectWordRegex.FindAllStringIndex(text, -1) {
+		result.Text += text[last:loc[0]]
+		last = loc[1]
+		quoted := text[loc[0]:loc[1]]
+
+		// Quote marks aren't tìftangs, so they go around the converted word.  Words that aren't Na'vi are left alone
+		start, end, ok := dialectQuotes(quoted, from)
+		result.Text += quoted[:start]
+		original := quoted[start:end]
+		if !ok {
+			result.Text += quoted[start:]
+			continue
+		}
+
+		options := []string{strings.ToLower(original)}
+		if from != DialectForest {
+			options = dialectToForest(options[0], from)
+		}
+		if to != DialectForest {
+			for i, a := range options {
+				options[i] = dialectFromForest(a, to)
+			}
+			options = slices.Compact(options)
+		}
+
+		for i, a := range options {
+			options[i] = keepCapital(original, a)
+		}
+
+		if len(options) > 1 {
+			result.Ambiguities = append(result.Ambiguities, DialectAmbiguity{original, len(result.Text), options})
+		}
+		result.Text += options[0] + quoted[end:]
+	}
+	result.Text += text[last:]
+
+	return
+}
+
+// Where the word is without the quote marks around it, and if it can be pronounced.  Like SpellCheck,
+// the whole thing is tried first (vonvä' ends with a tìftang), then without the one at the end, then without both
+func dialectQuotes(quoted string, dialect Dialect) (start int, end int, ok bool) {
+	isQuote := func(r rune) bool { return strings.ContainsRune("'’‘", r) }
+	end = len(strings.TrimRightFunc(quoted, isQuote))
+	start = len(quoted) - len(strings.TrimLeftFunc(quoted, isQuote))
+	if start >= end {
+		return 0, len(quoted), false // just quote marks
+	}
+
+	for _, a := range [][2]int{{0, len(quoted)}, {0, end}, {start, end}} {
+		if _, err := pronounce(strings.ToLower(quoted[a[0]:a[1]]), dialect); err == nil {
+			return a[0], a[1], true
+		}
+	}
+	return start, end, false
+}
+
+// If the original starts with a capital letter, so does the word
+func keepCapital(original string, word string) string {
+	first, _ := utf8.DecodeRuneInString(original)
+	if !unicode.IsUpper(first) {
+		return word
+	}
+	a, size := utf8.DecodeRuneInString(word)
+	return string(unicode.ToUpper(a)) + word[size:]
+}
+
+// Forest word into reef or interdialect.  Words that aren't Na'vi are left alone
+func dialectFromForest(word string, dialect Dialect) string {
+	pronunciation, err := pronounce(word, dialect)
+	if err != nil {
+		return word
+	}
+	return strings.Join(pronunciation.Syllables, "")
+}
+
+// Reef or interdialect word back into forest.  Every forest word that turns back into the same word counts,
+// with the ones the dictionary knows about first
+func dialectToForest(word string, dialect Dialect) []string {
+	phonemes := splitPhonemes(strings.ReplaceAll(word, "’", "'"))
+
+	// These always go the same way
+	choices := []int{}
+	for i := range phonemes {
+		switch phonemes[i] {
+		case "b":
+			phonemes[i] = "px"
+		case "d":
+			phonemes[i] = "tx"
+		case "g":
+			phonemes[i] = "kx"
+		case "ù":
+			phonemes[i] = "u"
+		case "ch":
+			phonemes[i] = "tsy"
+		case "sh":
+			phonemes[i] = "sy"
+		}
+	}
+
+	// These might have been something else: e or ä, ng or n-kx, and a missing tìftang
+	for i, a := range phonemes {
+		switch {
+		case a == "e", a == "ng" && i != 0:
+			choices = append(choices, i)
+		case i > 0 && isNucleusPhoneme(a) && isNucleusPhoneme(phonemes[i-1]) && a != phonemes[i-1] &&
+			a != "ll" && a != "rr":
+			choices = append(choices, i)
+		}
+	}
+	if len(choices) > maxDialectChoices {
+		choices = choices[:maxDialectChoices]
+	}
+
+	plain := strings.Join(phonemes, "")
+	known := []string{}
+	others := []string{}
+	for mask := 0; mask < 1<<len(choices); mask++ {
+		candidate := slices.Clone(phonemes)
+		for j := len(choices) - 1; j >= 0; j-- {
+			if mask&(1<<j) == 0 {
+				continue
+			}
+			i := choices[j]
+			switch candidate[i] {
+			case "e":
+				candidate[i] = "ä"
+			case "ng":
+				candidate = slices.Replace(candidate, i, i+1, "n", "kx")
+			default:
+				candidate = slices.Insert(candidate, i, "'")
+			}
+		}
+		forest := strings.Join(candidate, "")
+
+		// It has to come back out the same
+		if dialectFromForest(forest, dialect) != word {
+			continue
+		}
+		_, matches, err := TranslateFromNaviHashHelper(&dictHashStrict, 0, []string{forest}, true, false, false)
+		if err == nil && len(matches) > 0 && len(matches[0]) > 1 {
+			known = append(known, forest)
+		} else {
+			others = append(others, forest)
+		}
+	}
+
+	if len(known) > 0 {
+		return known
+	}
+	// Nothing in the dictionary, so go with the simplest spelling
+	if len(others) > 0 {
+		return others[:1]
+	}
+	return []string{plain}
+}
@@ -0,0 +1,49 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConvertDialect(t *testing.T) {
+	CacheDictHash()
+	tests := []struct {
+		text        string
+		from        Dialect
+		to          Dialect
+		want        string
+		ambiguities []DialectAmbiguity
+	}{
+		{"Kaltxì, ma tsmukan!", DialectForest, DialectReef, "Kaldì, ma tsmukan!", nil},
+		{"Fìtsyalit sìltsan ke lu.", DialectForest, DialectReef, "Fìchalit sìltsan ke lu.", nil},
+		{"Sìltsanä tìtaron", DialectForest, DialectReef, "Sìltsane tìtaron", nil},
+		{"Sìltsanä tìtaron", DialectForest, DialectBoth, "Sìltsane tìtaron", nil},
+		{"Kaldì, ma tsmukan!", DialectReef, DialectForest, "Kaltxì, ma tsmukan!", nil},
+		{"Fìchalit", DialectReef, DialectForest, "Fìtsyalit", nil},
+		{"ma tsmuke", DialectReef, DialectForest, "ma tsmuke", []DialectAmbiguity{{"tsmuke", 3, []string{"tsmuke", "tsmukä"}}}},
+		{"Jake", DialectForest, DialectReef, "Jake", nil},
+		{"McDonald", DialectForest, DialectReef, "McDonald", nil},
+		{"McDonald", DialectReef, DialectForest, "McDonald", nil},
+		{"'Kaltxì'", DialectForest, DialectReef, "'Kaldì'", nil},
+		{"‘Kaltxì’ si", DialectForest, DialectReef, "‘Kaldì’ si", nil},
+		{"'Kaldì'", DialectReef, DialectForest, "'Kaltxì'", nil},
+		{"vonvä'", DialectForest, DialectReef, "vonvä'", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ConvertDialect(tt.text, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("ConvertDialect() error = %v", err)
+			}
+			if got.Text != tt.want {
+				t.Errorf("ConvertDialect() = %v, want %v", got.Text, tt.want)
+			}
+			if !reflect.DeepEqual(got.Ambiguities, tt.ambiguities) {
+				t.Errorf("ConvertDialect() Ambiguities = %v, want %v", got.Ambiguities, tt.ambiguities)
+			}
+		})
+	}
+	if _, err := ConvertDialect("Kaltxì", DialectForest, Dialect(3)); err != InvalidDialect {
+		t.Errorf("ConvertDialect() error = %v, want %v", err, InvalidDialect)
+	}
+}
@@ -14,8 +14,13 @@ const (
 	NumberTooBig       = constError("number too big")
 	NoTranslationFound = constError("no translation found")
 	// list
-	InvalidNumber = constError("invalidNumericError")
-	NoResults     = constError("noResultsError")
+	InvalidNumber  = constError("invalidNumericError")
+	InvalidPattern = constError("invalidPatternError")
+	NoResults      = constError("noResultsError")
+	// phonotactics
+	InvalidNavi = constError("invalidNaviError")
+	// dialects
+	InvalidDialect = constError("invalidDialectError")
 )
 
 // errors are basically strings, that implement the error interface
@@ -0,0 +1,186 @@
+package fwew_lib
+
+import (
+	"slices"
+	"strings"
+)
+
+// A word and the words made from it
+type FamilyNode struct {
+	Word     Word
+	Relation string // how it's made from the word above it, like "-yu", "tì-" or "compound".  Empty at the top
+	Children []FamilyNode
+}
+
+// Derivational affixes the deconjugator doesn't take off
+var familyPrefixes = []string{"tì", "sna", "fne"}
+var familySuffixes = []string{"tswo", "fkeyk"}
+
+// Shortest part of a compound without spaces, so short words like si don't split everything
+const familyCompoundMin = 3
+
+type familyEdge struct {
+	word     Word
+	relation string
+}
+
+// Every word made from a root (and from those words, and so on), like taronyu and tìtaron for taron.
+// Compounds are under every word they're made of.  The word can be conjugated.  Homonyms get a tree each
+func Family(word string) (families []FamilyNode, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	children := familyIndex()
+
+	// If it's a word, don't also give what it could be conjugated from.  The whole thing, so irayo si isn't irayo and si
+	if found := dictHashStrict[strings.ToLower(clean(word))]; len(found) > 0 {
+		for _, a := range found {
+			families = append(families, familyTree(children, a, "", map[string]bool{}))
+		}
+		return
+	}
+
+	results, err := translateFromNaviHash(word, true, false, true)
+	if err != nil {
+		return
+	}
+	roots := []Word{}
+	for _, a := range results {
+		for _, b := range a[1:] {
+			if root, ok := wordIndexes().byID[b.ID]; ok && !slices.ContainsFunc(roots, func(c Word) bool { return c.ID == b.ID }) {
+				roots = append(roots, root)
+			}
+		}
+	}
+	for _, a := range roots {
+		families = append(families, familyTree(children, a, "", map[string]bool{}))
+	}
+
+	if len(families) == 0 {
+		err = NoResults
+	}
+	return
+}
+
+func familyTree(children map[string][]familyEdge, word Word, relation string, seen map[string]bool) FamilyNode {
+	node := FamilyNode{Word: word, Relation: relation, Children: []FamilyNode{}}
+	seen[word.ID] = true
+	for _, a := range children[word.ID] {
+		if !seen[a.word.ID] {
+			node.Children = append(node.Children, familyTree(children, a.word, a.relation, seen))
+		}
+	}
+	return node
+}
+
+// Parent word ID to the words made from it, made the first time it's used
+func familyIndex() map[string][]familyEdge {
+	return derivedIndex("family", func() (map[string][]familyEdge, bool) {
+		return newFamilyIndex(), len(dictHashStrict) > 0
+	})
+}
+
+func newFamilyIndex() map[string][]familyEdge {
+	familyChildren := map[string][]familyEdge{}
+	for _, word := range wordIndexes().byID {
+		for _, parent := range familyParents(word) {
+			if !slices.ContainsFunc(familyChildren[parent.word.ID], func(a familyEdge) bool { return a.word.ID == word.ID }) {
+				familyChildren[parent.word.ID] = append(familyChildren[parent.word.ID], familyEdge{word, parent.relation})
+			}
+		}
+	}
+	for _, a := range familyChildren {
+		slices.SortFunc(a, func(b, c familyEdge) int {
+			return defaultCollator.Compare(b.word.Navi, c.word.Navi)
+		})
+	}
+	return familyChildren
+}
+
+// The words a word is made from.  Only the closest ones, so taronyutsyìp comes from taronyu, not taron
+func familyParents(word Word) (parents []familyEdge) {
+	navi := strings.ToLower(strings.ReplaceAll(word.Navi, "+", ""))
+	if strings.Contains(navi, " ") {
+		return familyCompound(word, strings.Fields(navi))
+	}
+
+	fewest := 0
+	add := func(parent Word, relation string, affixes int) {
+		if parent.ID == word.ID || (fewest != 0 && affixes > fewest) {
+			return
+		}
+		if affixes < fewest {
+			parents = nil
+		}
+		fewest = affixes
+		if !slices.ContainsFunc(parents, func(a familyEdge) bool { return a.word.ID == parent.ID }) {
+			parents = append(parents, familyEdge{parent, relation})
+		}
+	}
+
+	for _, a := range TestDeconjugations(&dictHashStrict, navi, true, false, false) {
+		// Lenition alone doesn't make a new word (fo isn't from po)
+		affixes := len(a.Affixes.Prefix) + len(a.Affixes.Infix) + len(a.Affixes.Suffix)
+		if affixes > 0 {
+			add(wordIndexes().byID[a.ID], familyRelation(a.Affixes), affixes)
+		}
+	}
+	for _, a := range familyPrefixes {
+		for _, b := range dictHashStrict[strings.TrimPrefix(navi, a)] {
+			if strings.HasPrefix(navi, a) {
+				add(b, a+"-", 1)
+			}
+		}
+	}
+	for _, a := range familySuffixes {
+		for _, b := range dictHashStrict[strings.TrimSuffix(navi, a)] {
+			if strings.HasSuffix(navi, a) {
+				add(b, "-"+a, 1)
+			}
+		}
+	}
+	if len(parents) > 0 {
+		return
+	}
+
+	// Two words stuck together, like kxetsekxetse
+	runes := []rune(navi)
+	for i := familyCompoundMin; i <= len(runes)-familyCompoundMin; i++ {
+		if parents = familyCompound(word, []string{string(runes[:i]), string(runes[i:])}); len(parents) > 0 {
+			return
+		}
+	}
+	return
+}
+
+// Every part has to be a word
+func familyCompound(word Word, parts []string) (parents []familyEdge) {
+	for _, a := range parts {
+		found := dictHashStrict[a]
+		if len(found) == 0 {
+			return nil
+		}
+		for _, b := range found {
+			if b.ID != word.ID && !slices.ContainsFunc(parents, func(c familyEdge) bool { return c.word.ID == b.ID }) {
+				parents = append(parents, familyEdge{b, "compound"})
+			}
+		}
+	}
+	return
+}
+
+// Like "ay- -tsyìp", in the order they're in the word
+func familyRelation(affixes affix) string {
+	parts := []string{}
+	for _, a := range affixes.Prefix {
+		parts = append(parts, a+"-")
+	}
+	for _, a := range affixes.Infix {
+		parts = append(parts, "<"+a+">")
+	}
+	for i := len(affixes.Suffix) - 1; i >= 0; i-- {
+		parts = append(parts, "-"+affixes.Suffix[i])
+	}
+	parts = append(parts, affixes.Lenition...)
+	return strings.Join(parts, " ")
+}
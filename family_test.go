@@ -0,0 +1,53 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Navi and Relation of every node, depth first
+func familyFlatten(node FamilyNode) (flat []string) {
+	flat = append(flat, node.Word.Navi+" "+node.Relation)
+	for _, a := range node.Children {
+		flat = append(flat, familyFlatten(a)...)
+	}
+	return
+}
+
+func TestFamily(t *testing.T) {
+	CacheDictHash()
+	tests := []struct {
+		word string
+		want [][]string
+	}{
+		{"taron", [][]string{{"taron ", "taronyu -yu", "taronyutsyìp -tsyìp", "tìtaron tì-"}}},
+		{"taronyu", [][]string{{"taronyu ", "taronyutsyìp -tsyìp"}}},
+		{"tarontaronyu", nil},
+		{"si", [][]string{{"si ", "irayo si compound", "txopu si compound"}}},
+		{"Irayo si", [][]string{{"irayo si "}}},
+		{"kxetse", [][]string{{"kxetse ", "kxetsekxetse compound"}}},
+		{"fo", [][]string{{"fo ", "ayfo ay-"}}},
+		{"po", [][]string{{"po ", "ayfo ay- p→f"}}},
+		{"txur", [][]string{{"txur ", "tìtxur tì-"}}},
+		{"pxel", [][]string{{"pxel "}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			families, err := Family(tt.word)
+			var got [][]string
+			for _, a := range families {
+				got = append(got, familyFlatten(a))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Family() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func Test_familyRelation(t *testing.T) {
+	got := familyRelation(affix{Prefix: []string{"ay"}, Infix: []string{"us"}, Suffix: []string{"it", "tsyìp"}, Lenition: []string{"ts→s"}})
+	if want := "ay- <us> -tsyìp -it ts→s"; got != want {
+		t.Errorf("familyRelation() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,353 @@
+package fwew_lib
+
+var message_form_unknown_affix = map[string]string{
+	"en": "{affix} isn't a Na'vi affix", // English
+	// TODO
+	"de": "{affix} isn't a Na'vi affix", // German (Deutsch)
+	// TODO
+	"es": "{affix} isn't a Na'vi affix", // Spanish (Español)
+	// TODO
+	"et": "{affix} isn't a Na'vi affix", // Estonian (Eesti)
+	// TODO
+	"fr": "{affix} isn't a Na'vi affix", // French (Français)
+	// TODO
+	"hu": "{affix} isn't a Na'vi affix", // Hungarian (Magyar)
+	// TODO
+	"it": "{affix} isn't a Na'vi affix", // Italian (Italiano)
+	// TODO
+	"ko": "{affix} isn't a Na'vi affix", // Korean (한국어)
+	// TODO
+	"nl": "{affix} isn't a Na'vi affix", // Dutch (Nederlands)
+	// TODO
+	"pl": "{affix} isn't a Na'vi affix", // Polish (Polski)
+	// TODO
+	"pt": "{affix} isn't a Na'vi affix", // Portuguese (Português)
+	// TODO
+	"ru": "{affix} isn't a Na'vi affix", // Russian (Русский)
+	// TODO
+	"sv": "{affix} isn't a Na'vi affix", // Swedish (Svenska)
+	// TODO
+	"tr": "{affix} isn't a Na'vi affix", // Turkish (Türkçe)
+	// TODO
+	"uk": "{affix} isn't a Na'vi affix", // Ukrainian (Українська)
+}
+
+var message_form_duplicate_affix = map[string]string{
+	"en": "{affix} can only be there once", // English
+	// TODO
+	"de": "{affix} can only be there once", // German (Deutsch)
+	// TODO
+	"es": "{affix} can only be there once", // Spanish (Español)
+	// TODO
+	"et": "{affix} can only be there once", // Estonian (Eesti)
+	// TODO
+	"fr": "{affix} can only be there once", // French (Français)
+	// TODO
+	"hu": "{affix} can only be there once", // Hungarian (Magyar)
+	// TODO
+	"it": "{affix} can only be there once", // Italian (Italiano)
+	// TODO
+	"ko": "{affix} can only be there once", // Korean (한국어)
+	// TODO
+	"nl": "{affix} can only be there once", // Dutch (Nederlands)
+	// TODO
+	"pl": "{affix} can only be there once", // Polish (Polski)
+	// TODO
+	"pt": "{affix} can only be there once", // Portuguese (Português)
+	// TODO
+	"ru": "{affix} can only be there once", // Russian (Русский)
+	// TODO
+	"sv": "{affix} can only be there once", // Swedish (Svenska)
+	// TODO
+	"tr": "{affix} can only be there once", // Turkish (Türkçe)
+	// TODO
+	"uk": "{affix} can only be there once", // Ukrainian (Українська)
+}
+
+var message_form_affix_clash = map[string]string{
+	"en": "{affix} cannot combine with {other}", // English
+	// TODO
+	"de": "{affix} cannot combine with {other}", // German (Deutsch)
+	// TODO
+	"es": "{affix} cannot combine with {other}", // Spanish (Español)
+	// TODO
+	"et": "{affix} cannot combine with {other}", // Estonian (Eesti)
+	// TODO
+	"fr": "{affix} cannot combine with {other}", // French (Français)
+	// TODO
+	"hu": "{affix} cannot combine with {other}", // Hungarian (Magyar)
+	// TODO
+	"it": "{affix} cannot combine with {other}", // Italian (Italiano)
+	// TODO
+	"ko": "{affix} cannot combine with {other}", // Korean (한국어)
+	// TODO
+	"nl": "{affix} cannot combine with {other}", // Dutch (Nederlands)
+	// TODO
+	"pl": "{affix} cannot combine with {other}", // Polish (Polski)
+	// TODO
+	"pt": "{affix} cannot combine with {other}", // Portuguese (Português)
+	// TODO
+	"ru": "{affix} cannot combine with {other}", // Russian (Русский)
+	// TODO
+	"sv": "{affix} cannot combine with {other}", // Swedish (Svenska)
+	// TODO
+	"tr": "{affix} cannot combine with {other}", // Turkish (Türkçe)
+	// TODO
+	"uk": "{affix} cannot combine with {other}", // Ukrainian (Українська)
+}
+
+var message_form_verb_only = map[string]string{
+	"en": "{affix} only goes on verbs", // English
+	// TODO
+	"de": "{affix} only goes on verbs", // German (Deutsch)
+	// TODO
+	"es": "{affix} only goes on verbs", // Spanish (Español)
+	// TODO
+	"et": "{affix} only goes on verbs", // Estonian (Eesti)
+	// TODO
+	"fr": "{affix} only goes on verbs", // French (Français)
+	// TODO
+	"hu": "{affix} only goes on verbs", // Hungarian (Magyar)
+	// TODO
+	"it": "{affix} only goes on verbs", // Italian (Italiano)
+	// TODO
+	"ko": "{affix} only goes on verbs", // Korean (한국어)
+	// TODO
+	"nl": "{affix} only goes on verbs", // Dutch (Nederlands)
+	// TODO
+	"pl": "{affix} only goes on verbs", // Polish (Polski)
+	// TODO
+	"pt": "{affix} only goes on verbs", // Portuguese (Português)
+	// TODO
+	"ru": "{affix} only goes on verbs", // Russian (Русский)
+	// TODO
+	"sv": "{affix} only goes on verbs", // Swedish (Svenska)
+	// TODO
+	"tr": "{affix} only goes on verbs", // Turkish (Türkçe)
+	// TODO
+	"uk": "{affix} only goes on verbs", // Ukrainian (Українська)
+}
+
+var message_form_noun_only = map[string]string{
+	"en": "{affix} only goes on nouns", // English
+	// TODO
+	"de": "{affix} only goes on nouns", // German (Deutsch)
+	// TODO
+	"es": "{affix} only goes on nouns", // Spanish (Español)
+	// TODO
+	"et": "{affix} only goes on nouns", // Estonian (Eesti)
+	// TODO
+	"fr": "{affix} only goes on nouns", // French (Français)
+	// TODO
+	"hu": "{affix} only goes on nouns", // Hungarian (Magyar)
+	// TODO
+	"it": "{affix} only goes on nouns", // Italian (Italiano)
+	// TODO
+	"ko": "{affix} only goes on nouns", // Korean (한국어)
+	// TODO
+	"nl": "{affix} only goes on nouns", // Dutch (Nederlands)
+	// TODO
+	"pl": "{affix} only goes on nouns", // Polish (Polski)
+	// TODO
+	"pt": "{affix} only goes on nouns", // Portuguese (Português)
+	// TODO
+	"ru": "{affix} only goes on nouns", // Russian (Русский)
+	// TODO
+	"sv": "{affix} only goes on nouns", // Swedish (Svenska)
+	// TODO
+	"tr": "{affix} only goes on nouns", // Turkish (Türkçe)
+	// TODO
+	"uk": "{affix} only goes on nouns", // Ukrainian (Українська)
+}
+
+var message_form_no_infixes = map[string]string{
+	"en": "**{word}** doesn't take infixes", // English
+	// TODO
+	"de": "**{word}** doesn't take infixes", // German (Deutsch)
+	// TODO
+	"es": "**{word}** doesn't take infixes", // Spanish (Español)
+	// TODO
+	"et": "**{word}** doesn't take infixes", // Estonian (Eesti)
+	// TODO
+	"fr": "**{word}** doesn't take infixes", // French (Français)
+	// TODO
+	"hu": "**{word}** doesn't take infixes", // Hungarian (Magyar)
+	// TODO
+	"it": "**{word}** doesn't take infixes", // Italian (Italiano)
+	// TODO
+	"ko": "**{word}** doesn't take infixes", // Korean (한국어)
+	// TODO
+	"nl": "**{word}** doesn't take infixes", // Dutch (Nederlands)
+	// TODO
+	"pl": "**{word}** doesn't take infixes", // Polish (Polski)
+	// TODO
+	"pt": "**{word}** doesn't take infixes", // Portuguese (Português)
+	// TODO
+	"ru": "**{word}** doesn't take infixes", // Russian (Русский)
+	// TODO
+	"sv": "**{word}** doesn't take infixes", // Swedish (Svenska)
+	// TODO
+	"tr": "**{word}** doesn't take infixes", // Turkish (Türkçe)
+	// TODO
+	"uk": "**{word}** doesn't take infixes", // Ukrainian (Українська)
+}
+
+var message_form_case_vowel = map[string]string{
+	"en": "{affix} is for vowel-final nouns", // English
+	// TODO
+	"de": "{affix} is for vowel-final nouns", // German (Deutsch)
+	// TODO
+	"es": "{affix} is for vowel-final nouns", // Spanish (Español)
+	// TODO
+	"et": "{affix} is for vowel-final nouns", // Estonian (Eesti)
+	// TODO
+	"fr": "{affix} is for vowel-final nouns", // French (Français)
+	// TODO
+	"hu": "{affix} is for vowel-final nouns", // Hungarian (Magyar)
+	// TODO
+	"it": "{affix} is for vowel-final nouns", // Italian (Italiano)
+	// TODO
+	"ko": "{affix} is for vowel-final nouns", // Korean (한국어)
+	// TODO
+	"nl": "{affix} is for vowel-final nouns", // Dutch (Nederlands)
+	// TODO
+	"pl": "{affix} is for vowel-final nouns", // Polish (Polski)
+	// TODO
+	"pt": "{affix} is for vowel-final nouns", // Portuguese (Português)
+	// TODO
+	"ru": "{affix} is for vowel-final nouns", // Russian (Русский)
+	// TODO
+	"sv": "{affix} is for vowel-final nouns", // Swedish (Svenska)
+	// TODO
+	"tr": "{affix} is for vowel-final nouns", // Turkish (Türkçe)
+	// TODO
+	"uk": "{affix} is for vowel-final nouns", // Ukrainian (Українська)
+}
+
+var message_form_case_consonant = map[string]string{
+	"en": "{affix} is for consonant-final nouns", // English
+	// TODO
+	"de": "{affix} is for consonant-final nouns", // German (Deutsch)
+	// TODO
+	"es": "{affix} is for consonant-final nouns", // Spanish (Español)
+	// TODO
+	"et": "{affix} is for consonant-final nouns", // Estonian (Eesti)
+	// TODO
+	"fr": "{affix} is for consonant-final nouns", // French (Français)
+	// TODO
+	"hu": "{affix} is for consonant-final nouns", // Hungarian (Magyar)
+	// TODO
+	"it": "{affix} is for consonant-final nouns", // Italian (Italiano)
+	// TODO
+	"ko": "{affix} is for consonant-final nouns", // Korean (한국어)
+	// TODO
+	"nl": "{affix} is for consonant-final nouns", // Dutch (Nederlands)
+	// TODO
+	"pl": "{affix} is for consonant-final nouns", // Polish (Polski)
+	// TODO
+	"pt": "{affix} is for consonant-final nouns", // Portuguese (Português)
+	// TODO
+	"ru": "{affix} is for consonant-final nouns", // Russian (Русский)
+	// TODO
+	"sv": "{affix} is for consonant-final nouns", // Swedish (Svenska)
+	// TODO
+	"tr": "{affix} is for consonant-final nouns", // Turkish (Türkçe)
+	// TODO
+	"uk": "{affix} is for consonant-final nouns", // Ukrainian (Українська)
+}
+
+var message_form_case_ending = map[string]string{
+	"en": "{affix} doesn't go on **{word}**", // English
+	// TODO
+	"de": "{affix} doesn't go on **{word}**", // German (Deutsch)
+	// TODO
+	"es": "{affix} doesn't go on **{word}**", // Spanish (Español)
+	// TODO
+	"et": "{affix} doesn't go on **{word}**", // Estonian (Eesti)
+	// TODO
+	"fr": "{affix} doesn't go on **{word}**", // French (Français)
+	// TODO
+	"hu": "{affix} doesn't go on **{word}**", // Hungarian (Magyar)
+	// TODO
+	"it": "{affix} doesn't go on **{word}**", // Italian (Italiano)
+	// TODO
+	"ko": "{affix} doesn't go on **{word}**", // Korean (한국어)
+	// TODO
+	"nl": "{affix} doesn't go on **{word}**", // Dutch (Nederlands)
+	// TODO
+	"pl": "{affix} doesn't go on **{word}**", // Polish (Polski)
+	// TODO
+	"pt": "{affix} doesn't go on **{word}**", // Portuguese (Português)
+	// TODO
+	"ru": "{affix} doesn't go on **{word}**", // Russian (Русский)
+	// TODO
+	"sv": "{affix} doesn't go on **{word}**", // Swedish (Svenska)
+	// TODO
+	"tr": "{affix} doesn't go on **{word}**", // Turkish (Türkçe)
+	// TODO
+	"uk": "{affix} doesn't go on **{word}**", // Ukrainian (Українська)
+}
+
+var message_form_forbidden_affix = map[string]string{
+	"en": "**{word}** cannot take {affix}", // English
+	// TODO
+	"de": "**{word}** cannot take {affix}", // German (Deutsch)
+	// TODO
+	"es": "**{word}** cannot take {affix}", // Spanish (Español)
+	// TODO
+	"et": "**{word}** cannot take {affix}", // Estonian (Eesti)
+	// TODO
+	"fr": "**{word}** cannot take {affix}", // French (Français)
+	// TODO
+	"hu": "**{word}** cannot take {affix}", // Hungarian (Magyar)
+	// TODO
+	"it": "**{word}** cannot take {affix}", // Italian (Italiano)
+	// TODO
+	"ko": "**{word}** cannot take {affix}", // Korean (한국어)
+	// TODO
+	"nl": "**{word}** cannot take {affix}", // Dutch (Nederlands)
+	// TODO
+	"pl": "**{word}** cannot take {affix}", // Polish (Polski)
+	// TODO
+	"pt": "**{word}** cannot take {affix}", // Portuguese (Português)
+	// TODO
+	"ru": "**{word}** cannot take {affix}", // Russian (Русский)
+	// TODO
+	"sv": "**{word}** cannot take {affix}", // Swedish (Svenska)
+	// TODO
+	"tr": "**{word}** cannot take {affix}", // Turkish (Türkçe)
+	// TODO
+	"uk": "**{word}** cannot take {affix}", // Ukrainian (Українська)
+}
+
+var message_form_lenition = map[string]string{
+	"en": "Nothing here causes the lenition {affix}", // English
+	// TODO
+	"de": "Nothing here causes the lenition {affix}", // German (Deutsch)
+	// TODO
+	"es": "Nothing here causes the lenition {affix}", // Spanish (Español)
+	// TODO
+	"et": "Nothing here causes the lenition {affix}", // Estonian (Eesti)
+	// TODO
+	"fr": "Nothing here causes the lenition {affix}", // French (Français)
+	// TODO
+	"hu": "Nothing here causes the lenition {affix}", // Hungarian (Magyar)
+	// TODO
+	"it": "Nothing here causes the lenition {affix}", // Italian (Italiano)
+	// TODO
+	"ko": "Nothing here causes the lenition {affix}", // Korean (한국어)
+	// TODO
+	"nl": "Nothing here causes the lenition {affix}", // Dutch (Nederlands)
+	// TODO
+	"pl": "Nothing here causes the lenition {affix}", // Polish (Polski)
+	// TODO
+	"pt": "Nothing here causes the lenition {affix}", // Portuguese (Português)
+	// TODO
+	"ru": "Nothing here causes the lenition {affix}", // Russian (Русский)
+	// TODO
+	"sv": "Nothing here causes the lenition {affix}", // Swedish (Svenska)
+	// TODO
+	"tr": "Nothing here causes the lenition {affix}", // Turkish (Türkçe)
+	// TODO
+	"uk": "Nothing here causes the lenition {affix}", // Ukrainian (Українська)
+}
@@ -0,0 +1,257 @@
+package fwew_lib
+
+import (
+	"slices"
+	"strings"
+)
+
+// So other packages can make affixes for CheckForm
+type Affixes = affix
+
+// Rule IDs for the things that can be wrong with a conjugation
+const (
+	RuleUnknownAffix   = "unknown-affix"
+	RuleDuplicateAffix = "duplicate-affix"
+	RuleAffixClash     = "affix-clash"
+	RuleVerbOnly       = "verb-only"
+	RuleNounOnly       = "noun-only"
+	RuleNoInfixes      = "no-infixes"
+	RuleCaseVowel      = "case-vowel"
+	RuleCaseConsonant  = "case-consonant"
+	RuleCaseEnding     = "case-ending"
+	RuleForbiddenAffix = "forbidden-affix"
+	RuleLenition       = "lenition"
+)
+
+// One way a conjugation breaks the grammar
+type FormViolation struct {
+	Rule  string
+	Affix string // like "fì-", "<ay>", "-ru" or "t→s"
+	Other string // the affix it clashes with, if there is one
+}
+
+type FormCheck struct {
+	Word       string
+	Valid      bool
+	Violations []FormViolation
+}
+
+// Prefixes that can't be together.  fay, tsay and pay are two prefixes in one
+var formPrefixClashes = [][]string{
+	{"fì", "tsa", "pe", "fra", "fay", "tsay", "pay"},
+	{"ay", "me", "pxe", "fay", "tsay", "pay"},
+}
+
+// Affixes that make a verb into a noun
+var formNominalizers = []string{"yu", "tswo", "tseng"}
+
+// Prefixes that lenite the word after them
+var formLenitingPrefixes = slices.Concat(prefixes1lenition, prefixes1NounsLenition, []string{"tsay", "pe"})
+
+// If root with these affixes is grammatical.  Prefixes and infixes are in the order they're in the word,
+// suffixes go from the outside in like in the Words TranslateFromNaviHash finds, so taronyuti is Suffix: {"ti", "yu"}.
+// The lenition is like "t→s"
+func CheckForm(root Word, affixes Affixes) (result FormCheck) {
+	result.Word = root.Navi
+	word := strings.ToLower(root.Navi)
+	add := func(rule string, affix string, other string) {
+		result.Violations = append(result.Violations, FormViolation{rule, affix, other})
+	}
+
+	prefixes := formNormalize(affixes.Prefix)
+	infixes := formNormalize(affixes.Infix)
+	suffixes := formNormalize(affixes.Suffix)
+
+	verb := strings.HasPrefix(root.PartOfSpeech, "v")
+	noun := isNoun(root.PartOfSpeech) || (verb && (Contains(suffixes, formNominalizers) ||
+		(ContainsStr(prefixes, "tì") && ContainsStr(infixes, "us"))))
+
+	// Prefixes
+	known := slices.Concat(prefixes1Nouns, prefixes1NounsLenition, prefixes1lenition, stemPrefixes, verbPrefixes,
+		[]string{"a", "fra", "nì", "pe", "tì"})
+	for i, a := range prefixes {
+		switch {
+		case !ContainsStr(known, a):
+			add(RuleUnknownAffix, a+"-", "")
+		case ContainsStr(prefixes[:i], a):
+			add(RuleDuplicateAffix, a+"-", "")
+		case ContainsStr(verbPrefixes, a) && !verb:
+			add(RuleVerbOnly, a+"-", "")
+		case ContainsStr(slices.Concat(prefixes1Nouns, prefixes1NounsLenition, prefixes1lenition, stemPrefixes, []string{"fra", "pe"}), a) && !noun:
+			add(RuleNounOnly, a+"-", "")
+		default:
+			for _, b := range prefixes[:i] {
+				if b != a && slices.ContainsFunc(formPrefixClashes, func(c []string) bool { return ContainsStr(c, a) && ContainsStr(c, b) }) {
+					add(RuleAffixClash, a+"-", b+"-")
+					break
+				}
+			}
+		}
+	}
+
+	// Infixes
+	slots := []string{"", "", ""}
+	for i, a := range infixes {
+		ok := false
+		_, ok1 := prefirstMap[a]
+		_, ok2 := firstMap[a]
+		_, ok3 := secondMap[a]
+		switch {
+		case !ok1 && !ok2 && !ok3:
+			add(RuleUnknownAffix, "<"+a+">", "")
+		case ContainsStr(infixes[:i], a):
+			add(RuleDuplicateAffix, "<"+a+">", "")
+		case !verb:
+			add(RuleVerbOnly, "<"+a+">", "")
+		case NullDef(root.InfixLocations):
+			if i == 0 {
+				add(RuleNoInfixes, "<"+a+">", "")
+			}
+		default:
+			if ok, slots = verifyInfix(slots, a); !ok {
+				add(RuleAffixClash, "<"+a+">", "<"+slots[formInfixSlot(a)]+">")
+			}
+		}
+	}
+
+	// Suffixes, from the inside out
+	adpositions := []string{}
+	stem := word
+	for i := len(suffixes) - 1; i >= 0; i-- {
+		a := suffixes[i]
+		switch {
+		case !ContainsStr(slices.Concat(adposuffixes, stemSuffixes, verbSuffixes, []string{"a", "o", "pe", "sì"}), a):
+			add(RuleUnknownAffix, "-"+a, "")
+		case ContainsStr(suffixes[i+1:], a):
+			add(RuleDuplicateAffix, "-"+a, "")
+		case ContainsStr(verbSuffixes, a) && !verb:
+			add(RuleVerbOnly, "-"+a, "")
+		case ContainsStr(adposuffixes, a):
+			if !noun {
+				add(RuleNounOnly, "-"+a, "")
+			} else if len(adpositions) > 0 {
+				add(RuleAffixClash, "-"+a, "-"+adpositions[0])
+			} else if !verifyCaseEnding(stem, a) {
+				// Say why with the easiest nouns there are
+				vowel, consonant := verifyCaseEnding("na", a), verifyCaseEnding("nan", a)
+				if vowel && !consonant {
+					add(RuleCaseVowel, "-"+a, "")
+				} else if consonant && !vowel {
+					add(RuleCaseConsonant, "-"+a, "")
+				} else {
+					add(RuleCaseEnding, "-"+a, "")
+				}
+			}
+			adpositions = append(adpositions, a)
+		}
+		stem += a
+	}
+
+	// Words like fìtseng already have some affixes in them
+	if forbidden, ok := productiveCompounds[word]; ok {
+		for i, a := range [][]string{prefixes, infixes, suffixes} {
+			for _, b := range a {
+				if ContainsStr(forbidden[i], b) {
+					add(RuleForbiddenAffix, formAffix(i, b), "")
+				}
+			}
+		}
+	}
+
+	// Lenition without any prefixes is the short plural, like sute
+	shortPlural := noun && len(prefixes) == 0
+	if len(affixes.Lenition) > 0 && !shortPlural && !Contains(prefixes, formLenitingPrefixes) {
+		add(RuleLenition, affixes.Lenition[0], "")
+	}
+
+	result.Valid = len(result.Violations) == 0
+	return
+}
+
+// If one of the parts of speech is a noun.  Looking for "n." in the whole thing would find vin.
+func isNoun(partOfSpeech string) bool {
+	for _, a := range strings.Split(partOfSpeech, ",") {
+		switch strings.TrimSpace(a) {
+		case n, pn, propN:
+			return true
+		}
+	}
+	return false
+}
+
+// The spelling the tables use, like äp for ap
+func formNormalize(fixes []string) (normalized []string) {
+	for _, a := range fixes {
+		a = strings.ToLower(strings.Trim(a, "-<> "))
+		if b, ok := unreefFixes[a]; ok {
+			a = b
+		}
+		if b, ok := unstrictFixes[a]; ok {
+			a = b
+		}
+		normalized = append(normalized, a)
+	}
+	return
+}
+
+func formInfixSlot(infix string) int {
+	if _, ok := prefirstMap[infix]; ok {
+		return 0
+	} else if _, ok := firstMap[infix]; ok {
+		return 1
+	}
+	return 2
+}
+
+// Prefix, infix or suffix, the way the messages show it
+func formAffix(kind int, affix string) string {
+	switch kind {
+	case 0:
+		return affix + "-"
+	case 1:
+		return "<" + affix + ">"
+	}
+	return "-" + affix
+}
+
+// Every violation as a localized message
+func (c FormCheck) Messages(lang string) (messages []string) {
+	// Protect against odd language values
+	if _, ok := message_form_unknown_affix[lang]; !ok {
+		lang = "en" // default to English
+	}
+
+	for _, v := range c.Violations {
+		var message string
+		switch v.Rule {
+		case RuleUnknownAffix:
+			message = message_form_unknown_affix[lang]
+		case RuleDuplicateAffix:
+			message = message_form_duplicate_affix[lang]
+		case RuleAffixClash:
+			message = message_form_affix_clash[lang]
+		case RuleVerbOnly:
+			message = message_form_verb_only[lang]
+		case RuleNounOnly:
+			message = message_form_noun_only[lang]
+		case RuleNoInfixes:
+			message = message_form_no_infixes[lang]
+		case RuleCaseVowel:
+			message = message_form_case_vowel[lang]
+		case RuleCaseConsonant:
+			message = message_form_case_consonant[lang]
+		case RuleCaseEnding:
+			message = message_form_case_ending[lang]
+		case RuleForbiddenAffix:
+			message = message_form_forbidden_affix[lang]
+		case RuleLenition:
+			message = message_form_lenition[lang]
+		default:
+			continue
+		}
+		message = strings.ReplaceAll(message, "{affix}", v.Affix)
+		message = strings.ReplaceAll(message, "{other}", v.Other)
+		messages = append(messages, strings.ReplaceAll(message, "{word}", c.Word))
+	}
+	return
+}
@@ -0,0 +1,72 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckForm(t *testing.T) {
+	CacheDictHash()
+	root := func(navi string) Word {
+		results, err := TranslateFromNaviHash(navi, false, false, false)
+		if err != nil || len(results) == 0 || len(results[0]) < 2 {
+			t.Fatalf("no %s in the dictionary", navi)
+		}
+		return results[0][1]
+	}
+
+	tests := []struct {
+		word    string
+		affixes Affixes
+		want    []string
+	}{
+		{"tute", Affixes{Suffix: []string{"ru"}}, nil},
+		{"tsmukan", Affixes{Suffix: []string{"ru"}}, []string{"-ru is for vowel-final nouns"}},
+		{"tute", Affixes{Suffix: []string{"ur"}}, []string{"-ur is for consonant-final nouns"}},
+		{"taron", Affixes{Infix: []string{"ay", "ats"}}, nil},
+		{"taron", Affixes{Infix: []string{"ay", "am"}}, []string{"<am> cannot combine with <ay>"}},
+		{"tute", Affixes{Infix: []string{"ay"}}, []string{"<ay> only goes on verbs"}},
+		{"tute", Affixes{Prefix: []string{"fì", "tsa"}}, []string{"tsa- cannot combine with fì-"}},
+		{"tute", Affixes{Prefix: []string{"ay"}, Lenition: []string{"t→s"}}, nil},
+		{"tute", Affixes{Lenition: []string{"t→s"}}, nil}, // short plural
+		{"tute", Affixes{Prefix: []string{"pe"}, Lenition: []string{"t→s"}}, nil},
+		{"tute", Affixes{Prefix: []string{"fì"}, Lenition: []string{"t→s"}}, []string{"Nothing here causes the lenition t→s"}},
+		{"taron", Affixes{Lenition: []string{"t→s"}}, []string{"Nothing here causes the lenition t→s"}},
+		{"taron", Affixes{Suffix: []string{"ti", "yu"}}, nil},
+		{"taron", Affixes{Prefix: []string{"tì"}, Infix: []string{"us"}, Suffix: []string{"it"}}, nil},
+		{"taron", Affixes{Suffix: []string{"ti"}}, []string{"-ti only goes on nouns"}},
+		{"lu", Affixes{Suffix: []string{"ti"}}, []string{"-ti only goes on nouns"}}, // vin. isn't n.
+		{"lu", Affixes{Prefix: []string{"ay"}}, []string{"ay- only goes on nouns"}},
+		{"tute", Affixes{Suffix: []string{"it", "ru"}}, []string{"-it cannot combine with -ru"}},
+		{"tute", Affixes{Prefix: []string{"ay", "ay"}, Suffix: []string{"xyz"}}, []string{"ay- can only be there once", "-xyz isn't a Na'vi affix"}},
+		{"taronyutsyìp", Affixes{Suffix: []string{"tsyìp"}}, []string{"**taronyutsyìp** cannot take -tsyìp"}},
+		{"kame", Affixes{Infix: []string{"ap", "ay", "ats"}}, nil}, // ap is äp
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			got := CheckForm(root(tt.word), tt.affixes)
+			if got.Valid != (tt.want == nil) || !reflect.DeepEqual(got.Messages("en"), tt.want) {
+				t.Errorf("CheckForm() = %v %q, want %q", got.Valid, got.Messages("en"), tt.want)
+			}
+		})
+	}
+
+	// Anything found in the dictionary should be fine
+	results, _ := TranslateFromNaviHash("ayswizawìl", true, false, false)
+	if got := CheckForm(results[0][1], results[0][1].Affixes); !got.Valid {
+		t.Errorf("CheckForm() ayswizawìl = %v", got.Violations)
+	}
+
+	// Plurals and questions, with and without a prefix to cause the lenition
+	for _, a := range []string{"sute", "fo", "pesute", "pefo", "aysute", "fayfo", "suteri"} {
+		results, err := TranslateFromNaviHash(a, true, false, false)
+		if err != nil || len(results) == 0 || len(results[0]) < 2 {
+			t.Fatalf("no %s in the dictionary", a)
+		}
+		for _, b := range results[0][1:] {
+			if got := CheckForm(b, b.Affixes); !got.Valid {
+				t.Errorf("CheckForm() %s from %s = %q", a, b.Navi, got.Messages("en"))
+			}
+		}
+	}
+}
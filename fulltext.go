@@ -0,0 +1,225 @@
+package fwew_lib
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// A word whose definition matched a full-text search.  Snippet is the definition with the
+// matching words in **bold**, cut down around the first match if it's long
+type DefinitionHit struct {
+	Word    Word
+	Score   float64
+	Snippet string
+}
+
+// Words that are in too many definitions to mean anything
+var definitionStopWords = map[string][]string{
+	"en": {"a", "an", "the", "of", "to", "in", "on", "at", "for", "by", "with", "and", "or", "is", "be",
+		"it", "as", "from", "that", "this", "which", "who", "what", "about", "something", "someone", "sth", "sb"},
+	"de": {"der", "die", "das", "den", "dem", "des", "ein", "eine", "einer", "eines", "einem", "einen",
+		"und", "oder", "zu", "in", "im", "von", "vom", "mit", "für", "auf", "an", "am", "ist", "sein", "etwas", "jemand"},
+	"es": {"el", "la", "los", "las", "un", "una", "unos", "unas", "de", "del", "a", "al", "en", "y", "o",
+		"que", "por", "para", "con", "se", "ser", "algo", "alguien"},
+	"fr": {"le", "la", "les", "l'", "un", "une", "des", "de", "du", "d'", "à", "au", "aux", "en", "et", "ou",
+		"que", "qui", "pour", "par", "avec", "se", "être", "quelque", "chose", "quelqu'un"},
+	"ru": {"и", "в", "во", "на", "с", "со", "к", "по", "о", "об", "от", "для", "из", "или", "что", "как", "быть", "кто", "то"},
+	"pl": {"i", "w", "we", "na", "z", "ze", "do", "o", "od", "dla", "lub", "albo", "że", "się", "być", "coś", "ktoś"},
+}
+
+// BM25 settings
+const (
+	definitionK1 = 1.2
+	definitionB  = 0.75
+)
+
+// Longest snippet, in words
+const snippetWords = 12
+
+type definitionIndex struct {
+	words    []Word
+	lengths  []int
+	average  float64
+	postings map[string]map[int]int // term to word index to how many times it's there
+}
+
+// Search every definition in a language.  The best matches come first, at most limit of them (0 for all)
+func SearchDefinitions(query string, langCode string, limit int) (hits []DefinitionHit, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+	return searchDefinitions(query, langCode, limit)
+}
+
+func searchDefinitions(query string, langCode string, limit int) (hits []DefinitionHit, err error) {
+	// One index per language, made the first time it's searched
+	index := derivedIndex("definitions "+langCode, func() (*definitionIndex, bool) {
+		return newDefinitionIndex(langCode), len(dictHashStrict) > 0
+	})
+
+	terms := map[string]bool{}
+	for _, a := range definitionTerms(query, langCode) {
+		terms[a.term] = true
+	}
+
+	scores := map[int]float64{}
+	n := float64(len(index.words))
+	for term := range terms {
+		postings := index.postings[term]
+		idf := math.Log(1 + (n-float64(len(postings))+0.5)/(float64(len(postings))+0.5))
+		for i, count := range postings {
+			tf := float64(count)
+			norm := 1 - definitionB + definitionB*float64(index.lengths[i])/index.average
+			scores[i] += idf * tf * (definitionK1 + 1) / (tf + definitionK1*norm)
+		}
+	}
+
+	for i, score := range scores {
+		hits = append(hits, DefinitionHit{index.words[i], score, definitionSnippet(definitionOf(index.words[i], langCode), terms, langCode)})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return AlphabetizeHelper(hits[i].Word.Navi, hits[j].Word.Navi)
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	if len(hits) == 0 {
+		err = NoResults
+	}
+	return
+}
+
+func newDefinitionIndex(langCode string) *definitionIndex {
+	index := definitionIndex{postings: map[string]map[int]int{}}
+	seen := map[string]bool{}
+	total := 0
+	for _, words := range dictHashStrict {
+		for _, word := range words {
+			if seen[word.ID] || NullDef(definitionOf(word, langCode)) {
+				continue
+			}
+			seen[word.ID] = true
+
+			i := len(index.words)
+			terms := definitionTerms(definitionOf(word, langCode), langCode)
+			index.words = append(index.words, word)
+			index.lengths = append(index.lengths, len(terms))
+			total += len(terms)
+			for _, a := range terms {
+				if index.postings[a.term] == nil {
+					index.postings[a.term] = map[int]int{}
+				}
+				index.postings[a.term][i]++
+			}
+		}
+	}
+	index.average = max(float64(total)/float64(max(len(index.words), 1)), 1)
+	return &index
+}
+
+type definitionTerm struct {
+	term       string
+	start, end int // byte offsets of the word it came from
+}
+
+// Split text into normalized words, without stop words
+func definitionTerms(text string, langCode string) (terms []definitionTerm) {
+	start := -1
+	flush := func(end int) {
+		if start == -1 {
+			return
+		}
+		word := strings.ToLower(text[start:end])
+		if !ContainsStr(definitionStopWords[langCode], word) {
+			terms = append(terms, definitionTerm{NormalizeNatlang(word, langCode), start, end})
+		}
+		start = -1
+	}
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'' {
+			if start == -1 {
+				start = i
+			}
+		} else {
+			flush(i)
+		}
+	}
+	flush(len(text))
+	return
+}
+
+// The definition with the matches in bold, around the first match
+func definitionSnippet(definition string, matches map[string]bool, langCode string) string {
+	terms := definitionTerms(definition, langCode)
+	first := -1
+	for i, a := range terms {
+		if matches[a.term] {
+			first = i
+			break
+		}
+	}
+	if first == -1 {
+		return definition
+	}
+
+	// Only cut it if it's long
+	from, to := 0, len(definition)
+	prefix, suffix := "", ""
+	if len(terms) > snippetWords {
+		startTerm := max(first-snippetWords/3, 0)
+		endTerm := min(startTerm+snippetWords, len(terms)) - 1
+		if startTerm > 0 {
+			from = terms[startTerm].start
+			prefix = "…"
+		}
+		if endTerm < len(terms)-1 {
+			to = terms[endTerm].end
+			suffix = "…"
+		}
+	}
+
+	snippet := prefix
+	last := from
+	for _, a := range terms {
+		if a.start < from || a.end > to || !matches[a.term] {
+			continue
+		}
+		snippet += definition[last:a.start] + "**" + definition[a.start:a.end] + "**"
+		last = a.end
+	}
+	return snippet + definition[last:to] + suffix
+}
+
+// TranslateToNaviHash, and the words whose definitions match the search but aren't already in the results.
+// For showing "related results" under the usual ones
+func TranslateToNaviRelated(searchWord string, langCode string, limit int) (results [][]Word, related []DefinitionHit) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	results = translateToNaviHash(searchWord, langCode)
+	found := map[string]bool{}
+	for _, a := range results {
+		for _, b := range a[1:] {
+			found[b.ID] = true
+		}
+	}
+
+	hits, err := searchDefinitions(searchWord, langCode, 0)
+	if err != nil {
+		return
+	}
+	for _, a := range hits {
+		if !found[a.Word.ID] {
+			related = append(related, a)
+		}
+		if limit > 0 && len(related) == limit {
+			break
+		}
+	}
+	return
+}
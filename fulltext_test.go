@@ -0,0 +1,54 @@
+package fwew_lib
+
+import (
+	"testing"
+)
+
+func TestSearchDefinitions(t *testing.T) {
+	CacheDictHash()
+	got, err := SearchDefinitions("the friendly banshee", "en", 0)
+	if err != nil {
+		t.Fatalf("SearchDefinitions() error = %v", err)
+	}
+	if len(got) != 2 || got[0].Word.Navi != "le'eylan" || got[1].Word.Navi != "ikran" {
+		t.Fatalf("SearchDefinitions() = %v, want le'eylan and ikran", got)
+	}
+	if got[0].Score <= got[1].Score {
+		t.Errorf("SearchDefinitions() scores = %v, %v, want the shorter definition first", got[0].Score, got[1].Score)
+	}
+	if got[1].Snippet != "**banshee**, mountain **banshee**" {
+		t.Errorf("SearchDefinitions() snippet = %q", got[1].Snippet)
+	}
+
+	got, err = SearchDefinitions("hunting", "en", 1)
+	if err != nil || len(got) != 1 || got[0].Word.Navi != "tìtaron" {
+		t.Errorf("SearchDefinitions() = %v, %v, want tìtaron", got, err)
+	}
+
+	if _, err = SearchDefinitions("of the", "en", 0); err != NoResults {
+		t.Errorf("SearchDefinitions() error = %v, want %v", err, NoResults)
+	}
+}
+
+func TestDefinitionSnippet(t *testing.T) {
+	definition := "one two three four five six seven eight nine ten eleven twelve thirteen fourteen fifteen sixteen"
+	got := definitionSnippet(definition, map[string]bool{NormalizeNatlang("eight", "en"): true}, "en")
+	want := "…four five six seven **eight** nine ten eleven twelve thirteen fourteen fifteen…"
+	if got != want {
+		t.Errorf("definitionSnippet() = %q, want %q", got, want)
+	}
+}
+
+func TestTranslateToNaviRelated(t *testing.T) {
+	CacheDictHash()
+	CacheDictHash2()
+	results, related := TranslateToNaviRelated("sky", "en", 0)
+	if len(results) != 1 || len(results[0]) != 3 {
+		t.Fatalf("TranslateToNaviRelated() results = %v", results)
+	}
+	for _, a := range related {
+		if a.Word.Navi == "taw" || a.Word.Navi == "sawtute" {
+			t.Errorf("TranslateToNaviRelated() has %s in both results and related", a.Word.Navi)
+		}
+	}
+}
@@ -155,8 +155,10 @@ func clean(searchNaviWords string) (words string) {
 // The first word will only contain the query put into the translate command
 // One Navi-Word can have multiple meanings and words (e.g. synonyms)
 func TranslateFromNaviHash(searchNaviWords string, checkFixes bool, strict bool, allowReef bool) (results [][]Word, err error) {
-	universalLock.Lock()
-	defer universalLock.Unlock()
+	return TranslateFromNaviWith(searchNaviWords, SearchOptions{CheckFixes: checkFixes, Strict: strict, Dialect: searchDialect(allowReef)})
+}
+
+func translateFromNaviHash(searchNaviWords string, checkFixes bool, strict bool, allowReef bool) (results [][]Word, err error) {
 	searchNaviWords = clean(searchNaviWords)
 
 	// No Results if empty string after removing sketch chars
@@ -834,21 +836,57 @@ func SearchNatlangWord(wordmap map[string][]string, searchWord string) (results
 }
 
 func TranslateToNaviHash(searchWord string, langCode string) (results [][]Word) {
-	universalLock.Lock()
-	defer universalLock.Unlock()
+	return TranslateToNaviWith(searchWord, SearchOptions{Lang: langCode})
+}
+
+func translateToNaviHash(searchWord string, langCode string) (results [][]Word) {
 	searchWord = clean(searchWord)
 
 	results = [][]Word{}
 
-	for _, word := range strings.Split(searchWord, " ") {
+	allWords := strings.Split(searchWord, " ")
+
+	// "to be afraid" is in the definitions as "be afraid", but "to the sky" still needs its "to"
+	if langCode == "en" && len(allWords) > 2 && allWords[0] == "to" {
+		if steps, _ := TranslateToNaviPhraseHelper(allWords[1:], 0, langCode); steps > 0 {
+			allWords = allWords[1:]
+		}
+	}
+
+	for i := 0; i < len(allWords); i++ {
+		word := allWords[i]
 		// Skip empty words
 		if len(word) == 0 {
 			continue
 		}
+
+		// Phrases get their own group, so "thank you" doesn't give everything with "you" in it
+		steps, phraseResults := TranslateToNaviPhraseHelper(allWords, i, langCode)
+		if steps > 0 {
+			phrase := strings.Join(allWords[i:i+steps], " ")
+			results = append(results, append([]Word{simpleWord(phrase)}, phraseResults...))
+			i += steps - 1
+			continue
+		}
+
 		results = append(results, []Word{})
 		for _, a := range TranslateToNaviHashHelper(&dictHash2Parenthesis, word, langCode) {
 			results[len(results)-1] = AppendAndAlphabetize(results[len(results)-1], a)
 		}
+		// Other forms of the word ("trees" for "tree") go after the exact matches
+		exact := results[len(results)-1]
+		for _, a := range TranslateToNaviNormalizedHelper(word, langCode) {
+			duplicate := false
+			for _, b := range exact {
+				if a.ID == b.ID {
+					duplicate = true
+					break
+				}
+			}
+			if !duplicate {
+				results[len(results)-1] = append(results[len(results)-1], a)
+			}
+		}
 		// Append the query to the front of the list
 		tempResults := []Word{simpleWord(word)}
 		tempResults = append(tempResults, results[len(results)-1]...)
@@ -1096,8 +1134,10 @@ func TranslateToNaviHashHelper(dictionary *MetaDict, searchWord string, langCode
 // This will return a 2D array of Words, that fit the input text
 // One Word can have multiple meanings and words (e.g. synonyms)
 func BidirectionalSearch(searchNaviWords string, checkFixes bool, langCode string, allowReef bool) (results [][]Word, err error) {
-	universalLock.Lock()
-	defer universalLock.Unlock()
+	return BidirectionalSearchWith(searchNaviWords, SearchOptions{CheckFixes: checkFixes, Lang: langCode, Dialect: searchDialect(allowReef)})
+}
+
+func bidirectionalSearch(searchNaviWords string, checkFixes bool, strict bool, langCode string, allowReef bool) (results [][]Word, err error) {
 	searchNaviWords = clean(searchNaviWords)
 
 	// No Results if empty string after removing sketch chars
@@ -1112,12 +1152,14 @@ func BidirectionalSearch(searchNaviWords string, checkFixes bool, langCode strin
 	ourDict := &dictHashLoose
 	if !allowReef {
 		ourDict = &dictHashStrict
+	} else if strict {
+		ourDict = &dictHashStrictReef
 	}
 
 	results = [][]Word{}
 	for i < len(allWords) {
 		// Search for Na'vi words
-		j, newWords, error2 := TranslateFromNaviHashHelper(ourDict, i, allWords, checkFixes, false, allowReef)
+		j, newWords, error2 := TranslateFromNaviHashHelper(ourDict, i, allWords, checkFixes, strict, allowReef)
 
 		NaviIDs := []string{}
 		if error2 == nil {
@@ -1156,7 +1198,12 @@ func BidirectionalSearch(searchNaviWords string, checkFixes bool, langCode strin
 // If args are applied, the dict will be filtered for args before random words are chosen.
 // args will be put into the `List()` algorithm.
 func Random(amount int, args []string, checkDigraphs uint8) (results []Word, err error) {
-	allWords, err := List(args, checkDigraphs)
+	return RandomWith(amount, args, SearchOptions{CheckDigraphs: checkDigraphs})
+}
+
+// Random with named options.  Only CheckDigraphs is used; amount is how many there are
+func RandomWith(amount int, args []string, options SearchOptions) (results []Word, err error) {
+	allWords, err := ListWith(args, SearchOptions{CheckDigraphs: options.CheckDigraphs})
 
 	if err != nil {
 		log.Printf("Error getting fullDing: %s", err)
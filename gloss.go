@@ -0,0 +1,240 @@
+package fwew_lib
+
+import (
+	"html"
+	"strings"
+)
+
+// One piece of a glossed word.  A root with infixes keeps them inside it, like k<ol>ame and see<PFV>
+type GlossMorpheme struct {
+	Text  string
+	Gloss string
+}
+
+// One word of a glossed sentence.  Root is empty if the word isn't known
+type GlossWord struct {
+	Word      string
+	Root      Word
+	Morphemes []GlossMorpheme
+	Adjective AdjectiveUse // which side of its noun it's on, if it's an adjective
+}
+
+// A glossed sentence, one word at a time
+type GlossLine []GlossWord
+
+// Leipzig abbreviations for affixes.  Adpositions aren't here, they get their definition like roots do
+var glossPrefixes = map[string]string{
+	"ay": "PL", "me": "DU", "pxe": "TRI",
+	"fì": "PROX", "tsa": "DIST", "pe": "Q", "fay": "PROX.PL", "tsay": "DIST.PL", "pay": "Q.PL",
+	"fne": "TYPE", "sna": "COLL", "munsna": "PAIR",
+	"tsuk": "ABIL", "ketsuk": "NEG.ABIL",
+	"nì": "ADV", "tì": "NMLZ", "sä": "INSTR", "le": "ADJZ", "a": "ATTR",
+}
+
+var glossInfixes = map[string]string{
+	"äp": "REFL", "eyk": "CAUS", "äpeyk": "REFL.CAUS",
+	"am": "PST", "ìm": "RPST", "ay": "FUT", "ìy": "IRR.FUT",
+	"asy": "INT.FUT", "ìsy": "INT.IRR.FUT",
+	"alm": "PST.PFV", "ìlm": "RPST.PFV", "arm": "PST.IPFV", "ìrm": "RPST.IPFV",
+	"aly": "FUT.PFV", "ìly": "IRR.FUT.PFV", "ary": "FUT.IPFV", "ìry": "IRR.FUT.IPFV",
+	"ol": "PFV", "er": "IPFV",
+	"iv": "SBJV", "ilv": "PFV.SBJV", "irv": "IPFV.SBJV", "imv": "PST.SBJV", "ìyev": "FUT.SBJV", "iyev": "FUT.SBJV",
+	"us": "ACT.PTCP", "awn": "PASS.PTCP",
+	"ei": "LAUD", "eiy": "LAUD", "äng": "PEJ", "uy": "CEREM", "ats": "INFR",
+}
+
+var glossSuffixes = map[string]string{
+	"l": "ERG", "ìl": "ERG", "t": "PAT", "it": "PAT", "ti": "PAT",
+	"r": "DAT", "ur": "DAT", "ru": "DAT", "ä": "GEN", "yä": "GEN", "ri": "TOP", "ìri": "TOP",
+	"o": "INDF", "sì": "and",
+	"tsyìp": "DIM", "fkeyk": "STATE", "yu": "AG", "tswo": "ABIL.NMLZ", "tseng": "PLACE", "a": "ATTR",
+}
+
+// Personal pronouns get person and number instead of a definition
+var glossPronouns = map[string]string{
+	"oe": "1SG", "nga": "2SG", "po": "3SG",
+	"moe": "1DU.EXCL", "oeng": "1DU.INCL", "ayoe": "1PL.EXCL", "ayoeng": "1PL.INCL",
+	"fo": "3PL", "sno": "REFL",
+}
+
+// Break a sentence into morphemes and gloss them.  Punctuation and quote marks are left out
+func Gloss(sentence string, langCode string) (line GlossLine, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	for _, token := range tokenizeSentence(sentence) {
+		if token.Kind != TokenWord && token.Kind != TokenMultiword {
+			continue
+		}
+		allWords := strings.Fields(clean(token.Text))
+		if len(allWords) == 0 {
+			continue
+		}
+
+		word := GlossWord{Word: token.Text, Morphemes: []GlossMorpheme{{strings.ToLower(token.Text), "?"}}}
+		_, results, err2 := TranslateFromNaviHashHelper(&dictHashLoose, 0, allWords, true, false, true)
+		if err2 == nil && len(results) > 0 && len(results[0]) > 1 {
+			word.Root = results[0][1]
+			word.Morphemes = glossMorphemes(word.Root, langCode)
+		}
+		// The a by itself starts a relative clause
+		if len(allWords) == 1 && strings.ToLower(allWords[0]) == "a" {
+			word.Morphemes = []GlossMorpheme{{"a", "REL"}}
+		}
+		line = append(line, word)
+	}
+	glossAdjectives(line)
+
+	if len(line) == 0 {
+		err = NoResults
+	}
+	return
+}
+
+// The morphemes of a conjugated word, in order
+func glossMorphemes(root Word, langCode string) (morphemes []GlossMorpheme) {
+	for _, a := range root.Affixes.Prefix {
+		morphemes = append(morphemes, GlossMorpheme{a, glossAffix(a, glossPrefixes, langCode)})
+	}
+
+	// The root, lenited, with its infixes where they go
+	text := strings.ToLower(root.Navi)
+	if len(root.Affixes.Infix) > 0 && root.InfixLocations != valNull {
+		text = strings.ToLower(root.InfixLocations)
+	}
+	for _, a := range root.Affixes.Lenition {
+		from, to, found := strings.Cut(a, "→")
+		if found && strings.HasPrefix(text, from) {
+			text = to + strings.TrimPrefix(text, from)
+		}
+	}
+	gloss := glossDefinition(root, langCode)
+	for slot, marker := range []string{"<0>", "<1>", "<2>"} {
+		inside := ""
+		for _, a := range root.Affixes.Infix {
+			if glossInfixSlot(a) == slot {
+				inside += "<" + a + ">"
+				gloss += "<" + glossAffix(a, glossInfixes, langCode) + ">"
+			}
+		}
+		text = strings.ReplaceAll(text, marker, inside)
+	}
+	morphemes = append(morphemes, GlossMorpheme{text, gloss})
+
+	// Suffixes are listed outside in
+	for i := len(root.Affixes.Suffix) - 1; i >= 0; i-- {
+		a := root.Affixes.Suffix[i]
+		morphemes = append(morphemes, GlossMorpheme{a, glossAffix(a, glossSuffixes, langCode)})
+	}
+	return
+}
+
+// Which infix position an infix goes in
+func glossInfixSlot(infix string) int {
+	infix = glossStandardAffix(infix)
+	switch {
+	case prefirstMap[infix]:
+		return 0
+	case firstMap[infix]:
+		return 1
+	}
+	return 2
+}
+
+// The affix the way the abbreviations have it, so reef and no-diacritic spellings work too
+func glossStandardAffix(affix string) string {
+	if a, ok := unstrictFixes[affix]; ok {
+		affix = a
+	}
+	if a, ok := unreefFixes[affix]; ok {
+		affix = a
+	}
+	return affix
+}
+
+func glossAffix(affix string, abbreviations map[string]string, langCode string) string {
+	affix = glossStandardAffix(affix)
+	if a, ok := abbreviations[affix]; ok {
+		return a
+	}
+	// Adpositions are words in the dictionary
+	if words, ok := dictHashStrict[affix]; ok && len(words) > 0 {
+		return glossDefinition(words[0], langCode)
+	}
+	return affix
+}
+
+// A short definition: the first meaning without notes, with dots instead of spaces
+func glossDefinition(word Word, langCode string) string {
+	if a, ok := glossPronouns[strings.ToLower(word.Navi)]; ok {
+		return a
+	}
+
+	definition := definitionOf(word, langCode)
+	if NullDef(definition) {
+		definition = word.EN
+	}
+
+	definition, _, _ = strings.Cut(definition, ",")
+	definition, _, _ = strings.Cut(definition, ";")
+	for {
+		start := strings.Index(definition, "(")
+		end := strings.Index(definition, ")")
+		if start == -1 || end < start {
+			break
+		}
+		definition = definition[:start] + definition[end+1:]
+	}
+	return strings.Join(strings.Fields(definition), ".")
+}
+
+// The Na'vi line and the gloss line of a word, like oe-l and 1SG-ERG
+func (w GlossWord) Lines() (navi string, gloss string) {
+	navis := []string{}
+	glosses := []string{}
+	for _, a := range w.Morphemes {
+		navis = append(navis, a.Text)
+		glosses = append(glosses, a.Gloss)
+	}
+	return strings.Join(navis, "-"), strings.Join(glosses, "-")
+}
+
+// Two lines of plain text, with the words lined up
+func (g GlossLine) Text() string {
+	navi, gloss := "", ""
+	for i, a := range g {
+		n, l := a.Lines()
+		if i != len(g)-1 {
+			width := max(len([]rune(n)), len([]rune(l))) + 2
+			n += strings.Repeat(" ", width-len([]rune(n)))
+			l += strings.Repeat(" ", width-len([]rune(l)))
+		}
+		navi += n
+		gloss += l
+	}
+	return navi + "\n" + gloss
+}
+
+// A Markdown table with the Na'vi as the header row
+func (g GlossLine) Markdown() string {
+	escape := strings.NewReplacer("<", "\\<", ">", "\\>", "|", "\\|")
+	navi, line, gloss := "|", "|", "|"
+	for _, a := range g {
+		n, l := a.Lines()
+		navi += " " + escape.Replace(n) + " |"
+		line += " --- |"
+		gloss += " " + escape.Replace(l) + " |"
+	}
+	return navi + "\n" + line + "\n" + gloss + "\n"
+}
+
+// An HTML table with one row for the Na'vi and one for the gloss
+func (g GlossLine) HTML() string {
+	navi, gloss := "", ""
+	for _, a := range g {
+		n, l := a.Lines()
+		navi += "<td>" + html.EscapeString(n) + "</td>"
+		gloss += "<td>" + html.EscapeString(l) + "</td>"
+	}
+	return "<table>\n<tr>" + navi + "</tr>\n<tr>" + gloss + "</tr>\n</table>\n"
+}
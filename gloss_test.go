@@ -0,0 +1,58 @@
+package fwew_lib
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGloss(t *testing.T) {
+	CacheDictHash()
+	PhonemeDistros()
+	got, err := Gloss("Oel ngati kameie, tsmukanä taronyuo. Aysmukan fìtutet tarmaron!", "en")
+	if err != nil {
+		t.Fatalf("Gloss() error = %v", err)
+	}
+
+	want := [][]string{
+		{"oe-l", "1SG-ERG"},
+		{"nga-ti", "2SG-PAT"},
+		{"kam<ei>e", "see<LAUD>"},
+		{"tsmukan-ä", "brother-GEN"},
+		{"taron-yu-o", "hunt-AG-INDF"},
+		{"ay-smukan", "PL-brother"},
+		{"fì-tute-t", "PROX-person-PAT"},
+		{"t<arm>aron", "hunt<PST.IPFV>"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Gloss() = %v, want %d words", got, len(want))
+	}
+	for i, tt := range want {
+		navi, gloss := got[i].Lines()
+		if navi != tt[0] || gloss != tt[1] {
+			t.Errorf("Gloss() word %d = %s %s, want %s %s", i, navi, gloss, tt[0], tt[1])
+		}
+	}
+}
+
+func TestGlossRender(t *testing.T) {
+	CacheDictHash()
+	got, err := Gloss("Oel ngati kameie.", "en")
+	if err != nil {
+		t.Fatalf("Gloss() error = %v", err)
+	}
+
+	text := "oe-l     nga-ti   kam<ei>e\n1SG-ERG  2SG-PAT  see<LAUD>"
+	if got.Text() != text {
+		t.Errorf("Text() = %q, want %q", got.Text(), text)
+	}
+	if !strings.Contains(got.Markdown(), "| kam\\<ei\\>e |") || !strings.Contains(got.Markdown(), "| --- | --- | --- |") {
+		t.Errorf("Markdown() = %q", got.Markdown())
+	}
+	if !strings.Contains(got.HTML(), "<td>see&lt;LAUD&gt;</td>") {
+		t.Errorf("HTML() = %q", got.HTML())
+	}
+
+	if _, err := Gloss("?!", "en"); err != NoResults {
+		t.Errorf("Gloss() error = %v, want %v", err, NoResults)
+	}
+}
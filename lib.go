@@ -310,3 +310,21 @@ func decompress(syllables string) string {
 
 	return syll
 }
+
+// splitPhonemes breaks a word into its phonemes, keeping digraphs together
+func splitPhonemes(word string) (phonemes []string) {
+	for _, a := range tokenizePhonemes(word, nil) {
+		phonemes = append(phonemes, a.Text)
+	}
+	return
+}
+
+// Vowels, diphthongs and psuedovowels
+func isNucleusPhoneme(phoneme string) bool {
+	switch phoneme {
+	case "a", "ä", "e", "i", "ì", "o", "u", "ù",
+		"aw", "ay", "ew", "ey", "ll", "rr":
+		return true
+	}
+	return false
+}
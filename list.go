@@ -13,8 +13,10 @@ import (
 // It will try to always get 3 args and an `and` in between. If less than 3 exist, than it will wil return the previous
 // results.
 func List(args []string, checkDigraphs uint8) (results []Word, err error) {
-	universalLock.Lock()
-	defer universalLock.Unlock()
+	return ListWith(args, SearchOptions{CheckDigraphs: checkDigraphs})
+}
+
+func list(args []string, checkDigraphs uint8) (results []Word, err error) {
 	results, err = GetFullDict()
 
 	if err != nil {
@@ -27,6 +29,8 @@ func List(args []string, checkDigraphs uint8) (results []Word, err error) {
 		i++
 	}
 
+	args = joinPatternArgs(args)
+
 	for len(args) >= 3 {
 		// get 3 args and remove 4th
 		simpleArgs := args[0:3]
@@ -47,16 +51,70 @@ func List(args []string, checkDigraphs uint8) (results []Word, err error) {
 	return
 }
 
+// Phoneme patterns have spaces in them, so put them back together up to the next "and"
+func joinPatternArgs(args []string) (joined []string) {
+	i := 0
+	for i < len(args) {
+		if i+2 < len(args) && (args[i+1] == Text("c_pattern") || args[i+1] == Text("c_not-pattern")) {
+			end := i + 2
+			for end < len(args) && strings.ToLower(args[end]) != "and" {
+				end++
+			}
+			joined = append(joined, args[i], args[i+1], strings.Join(args[i+2:end], " "))
+			i = end
+		} else {
+			end := min(i+3, len(args))
+			joined = append(joined, args[i:end]...)
+			i = end
+		}
+		// keep the "and"
+		if i < len(args) {
+			joined = append(joined, args[i])
+			i++
+		}
+	}
+	return
+}
+
 func listWords(args []string, words []Word, checkDigraphs uint8) (results []Word, err error) {
 	what := strings.ToLower(args[0])
+	cond := strings.ToLower(args[1])
 	wordsLen := len(words)
 
+	// Bad patterns should be an error, not a panic
+	var pattern *PhonemePattern
+	var matches *regexp.Regexp
+	if what == Text("w_word") {
+		switch cond {
+		case Text("c_pattern"), Text("c_not-pattern"):
+			pattern, err = CompilePhonemePattern(args[2])
+		case Text("c_matches"):
+			spec := preventCompressBug(strings.ToLower(args[2]))
+			if checkDigraphs == 1 {
+				spec = compress(spec)
+			}
+			matches, err = regexp.Compile(spec)
+			if err != nil {
+				err = InvalidPattern.wrap(err)
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+
 	for i, word := range words {
 		switch what {
 		case Text("w_pos"):
 			results = filterPos(results, word, args)
 		case Text("w_word"):
-			results = filterWord(results, word, args, checkDigraphs)
+			if pattern != nil {
+				if pattern.Match(word) == (cond == Text("c_pattern")) {
+					results = append(results, word)
+				}
+				continue
+			}
+			results = filterWord(results, word, args, checkDigraphs, matches)
 		case Text("w_words"):
 			results, err = filterWords(results, word, args, wordsLen, i)
 		case Text("w_syllables"):
@@ -93,6 +151,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -124,6 +183,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -155,6 +215,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -186,6 +247,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -217,6 +279,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -248,6 +311,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -279,6 +343,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -310,6 +375,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -341,6 +407,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -372,6 +439,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -403,6 +471,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -434,6 +503,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -465,6 +535,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -496,6 +567,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -527,6 +599,7 @@ func ListHelp(lang string) (count string, err error) {
 		count = fmt.Sprintln("Commands formats for /list:\n",
 			"pos <string command 1> yourstring\n",
 			"word <string command 1 or 2> yourstring\n",
+			"word <\"pattern\", \"not-pattern\"> <phoneme pattern>\n",
 			"words <\"first\", \"last\"> <number>\n",
 			"syllables <number command> <number>\n",
 			"syllables <number command> <number>\n",
@@ -586,7 +659,7 @@ func filterPos(results []Word, word Word, args []string) []Word {
 	return results
 }
 
-func filterWord(results []Word, word Word, args []string, checkDigraphs uint8) []Word {
+func filterWord(results []Word, word Word, args []string, checkDigraphs uint8, matches *regexp.Regexp) []Word {
 	var (
 		cond = strings.ToLower(args[1])
 		spec = preventCompressBug(strings.ToLower(args[2]))
@@ -630,7 +703,7 @@ func filterWord(results []Word, word Word, args []string, checkDigraphs uint8) [
 		Text("c_not-ends"):    !strings.HasSuffix(syllables, spec),
 		Text("c_not-has"):     plus && !strings.Contains(navi, spec) || !strings.Contains(syllables, spec),
 		Text("c_not-like"):    !Glob(spec, syllables),
-		Text("c_matches"):     spec != "+" && regexp.MustCompile(spec).MatchString(navi),
+		Text("c_matches"):     spec != "+" && matches != nil && matches.MatchString(navi),
 	}
 
 	if condMap[cond] {
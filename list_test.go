@@ -3,6 +3,7 @@ package fwew_lib
 import (
 	"errors"
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -17,9 +18,73 @@ func TestList(t *testing.T) {
 		name        string
 		args        args
 		wantResults []Word
+		wantNavi    []string // words that have to be in the results
+		notNavi     []string // words that can't be
 		wantErr     error
 	}{
 		// TODO: Add test cases.
+		{
+			name: "word pattern C V C . C V",
+			args: args{
+				args: []string{"word", "pattern", "C", "V", "C", ".", "C", "V"},
+			},
+			wantResults: nil,
+			wantNavi:    []string{"'ampi", "kelku", "kaltxì"},
+			notNavi:     []string{"taron", "tute", "kelutral"},
+			wantErr:     nil,
+		},
+		{
+			name: "word pattern syllable 2 has coda and pos is n.",
+			args: args{
+				args: []string{"word", "pattern", "syllable", "2", "has", "coda", "and", "pos", "is", "n."},
+			},
+			wantResults: nil,
+			wantNavi:    []string{"taronyu", "ikran", "tsmukan"},
+			notNavi:     []string{"taron", "tute", "kelku"},
+			wantErr:     nil,
+		},
+		{
+			name: "word pattern [ejective] V *",
+			args: args{
+				args: []string{"word", "pattern", "[ejective]", "V", "*"},
+			},
+			wantResults: nil,
+			wantNavi:    []string{"txep", "kxetse", "pxun"},
+			notNavi:     []string{"taron", "kelku", "'ampi"},
+			wantErr:     nil,
+		},
+		{
+			name: "word pattern [foo]",
+			args: args{
+				args: []string{"word", "pattern", "[foo]"},
+			},
+			wantResults: nil,
+			wantErr:     InvalidPattern,
+		},
+		{
+			name: "word pattern syllable 2 has no",
+			args: args{
+				args: []string{"word", "pattern", "syllable", "2", "has", "no"},
+			},
+			wantResults: nil,
+			wantErr:     InvalidPattern,
+		},
+		{
+			name: "word pattern C V (",
+			args: args{
+				args: []string{"word", "pattern", "C", "V", "("},
+			},
+			wantResults: nil,
+			wantErr:     InvalidPattern,
+		},
+		{
+			name: "word matches (",
+			args: args{
+				args: []string{"word", "matches", "("},
+			},
+			wantResults: nil,
+			wantErr:     InvalidPattern,
+		},
 		{
 			name: "pos starts v",
 			args: args{
@@ -531,6 +596,21 @@ func TestList(t *testing.T) {
 				// for now, only check if something returns
 				t.Errorf("List() got empty result, expected something!")
 			}
+
+			navi := []string{}
+			for _, a := range gotResults {
+				navi = append(navi, a.Navi)
+			}
+			for _, a := range tt.wantNavi {
+				if !slices.Contains(navi, a) {
+					t.Errorf("List() gotResults = %v, want %s in it", navi, a)
+				}
+			}
+			for _, a := range tt.notNavi {
+				if slices.Contains(navi, a) {
+					t.Errorf("List() gotResults = %v, don't want %s in it", navi, a)
+				}
+			}
 			//if !reflect.DeepEqual(gotResults, tt.wantResults) {
 			//	t.Errorf("List() gotResults = %v, want %v", gotResults, tt.wantResults)
 			//}
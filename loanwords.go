@@ -0,0 +1,345 @@
+package fwew_lib
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// One way to say a foreign word in Na'vi
+type LoanwordCandidate struct {
+	Navi    string
+	Words   []Pronunciation // syllables and stress of each word
+	Changes int             // how much had to be changed to make it fit (lower is better)
+}
+
+// How letters sound, for every language
+var loanLetters = map[string][]string{
+	"a": {"a"}, "e": {"e"}, "i": {"i"}, "o": {"o"}, "u": {"u"},
+	"ä": {"ä"}, "ì": {"ì"}, "ù": {"ù"}, "ö": {"e"}, "ü": {"i"}, "ñ": {"n y"}, "ç": {"s"}, "ß": {"s"},
+	"á": {"a"}, "à": {"a"}, "â": {"a"}, "é": {"e"}, "è": {"e"}, "ê": {"e"}, "ë": {"e"},
+	"í": {"i"}, "î": {"i"}, "ï": {"i"}, "ó": {"o"}, "ô": {"o"}, "ú": {"u"}, "û": {"u"},
+	"b": {"p", "px"}, "d": {"t", "tx"}, "g": {"k", "kx"}, "p": {"p"}, "t": {"t"}, "k": {"k"},
+	"f": {"f"}, "v": {"v"}, "s": {"s"}, "z": {"z"}, "h": {"h"}, "m": {"m"}, "n": {"n"},
+	"l": {"l"}, "r": {"r"}, "w": {"w"}, "y": {"y"}, "'": {"'"},
+	"c": {"k"}, "j": {"ts y"}, "q": {"k"}, "x": {"k s"},
+	"sh": {"s y"}, "ch": {"ts y"}, "th": {"t", "s"}, "ph": {"f"}, "ck": {"k"}, "qu": {"k w"},
+	"ng": {"ng"}, "ts": {"ts"}, "tz": {"ts"}, "wh": {"w"},
+	"kx": {"kx"}, "px": {"px"}, "tx": {"tx"},
+}
+
+// What each language does differently
+var loanLanguages = map[string]map[string][]string{
+	"en": {
+		"oo": {"u"}, "ee": {"i"}, "ea": {"i", "e"}, "ai": {"ey"}, "ay": {"ey"}, "ey": {"ey"},
+		"ou": {"aw"}, "ow": {"aw", "o"}, "au": {"a"}, "oi": {"o i"}, "oy": {"o i"}, "igh": {"ay"},
+		"tch": {"ts y"}, "dge": {"ts y"},
+	},
+	"de": {
+		"sch": {"s y"}, "tsch": {"ts y"}, "ch": {"h", "k"}, "ei": {"ay"}, "ai": {"ay"}, "eu": {"o i"}, "äu": {"o i"},
+		"ie": {"i"}, "w": {"v"}, "v": {"f"}, "z": {"ts"}, "j": {"y"}, "y": {"i"},
+	},
+	"es": {
+		"ll": {"y"}, "rr": {"r"}, "j": {"h"}, "qu": {"k"}, "h": {""}, "z": {"s"}, "v": {"p", "px"},
+	},
+	"fr": {
+		"ou": {"u"}, "eau": {"o"}, "au": {"o"}, "ai": {"e"}, "ei": {"e"}, "oi": {"w a"}, "eu": {"e"},
+		"ch": {"s y"}, "j": {"s y"}, "gn": {"n y"}, "qu": {"k"}, "h": {""}, "u": {"i"},
+	},
+}
+
+// Don't try more than this many spellings of one word
+const maxLoanwordTries = 32
+
+// A letter Na'vi can't do anything with.  It gets dropped, which costs as much as dropping a consonant
+const loanUnknown = "?"
+
+// Turn a word (or name) from another language into Na'vi.  The best ones come first,
+// and every one of them passes the phonotactics checker.
+// sourceLang can be en, de, es or fr.  Anything else is read like English
+func AdaptLoanword(input string, sourceLang string) (candidates []LoanwordCandidate, err error) {
+	words := strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\'' && r != '’'
+	})
+	if len(words) == 0 {
+		return nil, NoResults
+	}
+
+	candidates = []LoanwordCandidate{{}}
+	for _, word := range words {
+		adapted := adaptLoanword(strings.ReplaceAll(word, "’", "'"), sourceLang)
+		if len(adapted) == 0 {
+			return nil, NoResults
+		}
+
+		// Every way to say it so far, with every way to say this word
+		newCandidates := []LoanwordCandidate{}
+		for _, a := range candidates {
+			for _, b := range adapted {
+				newCandidates = append(newCandidates, LoanwordCandidate{
+					Navi:    strings.TrimSpace(a.Navi + " " + b.Navi),
+					Words:   append(slices.Clone(a.Words), b.Words...),
+					Changes: a.Changes + b.Changes,
+				})
+			}
+		}
+		sort.SliceStable(newCandidates, func(i, j int) bool {
+			return newCandidates[i].Changes < newCandidates[j].Changes
+		})
+		candidates = newCandidates[:min(len(newCandidates), 5)]
+	}
+
+	return
+}
+
+// All the Na'vi versions of one word, best first
+func adaptLoanword(word string, lang string) (candidates []LoanwordCandidate) {
+	sounds := loanSounds(word, lang)
+
+	// Every combination of sounds, cheapest first
+	type try struct {
+		phonemes []string
+		changes  int
+	}
+	tries := []try{{}}
+	for _, sound := range sounds {
+		newTries := []try{}
+		for _, a := range tries {
+			for i, b := range sound {
+				if b == loanUnknown {
+					newTries = append(newTries, try{a.phonemes, a.changes + 3})
+					continue
+				}
+				newTries = append(newTries, try{append(slices.Clone(a.phonemes), strings.Fields(b)...), a.changes + i})
+			}
+		}
+		sort.SliceStable(newTries, func(i, j int) bool { return newTries[i].changes < newTries[j].changes })
+		tries = newTries[:min(len(newTries), maxLoanwordTries)]
+	}
+
+	seen := map[string]bool{}
+	for _, a := range tries {
+		for _, epenthesis := range []bool{true, false} {
+			phonemes, changes := repairLoanword(a.phonemes, epenthesis)
+			navi := strings.Join(phonemes, "")
+			if len(navi) == 0 || seen[navi] {
+				continue
+			}
+			seen[navi] = true
+
+			validation := ValidateNavi(navi)
+			if validation.Verdict == VerdictInvalid || validation.ReefOnly {
+				continue
+			}
+			if validation.Verdict == VerdictWarning {
+				changes++
+			}
+
+			pronunciation := Pronunciation{
+				Word:      navi,
+				Syllables: validation.Syllables,
+				Stressed:  loanwordStress(validation.Syllables, lang),
+				Guessed:   true,
+			}
+			pronunciation.IPA = syllablesToIPA(pronunciation.Syllables, pronunciation.Stressed)
+			pronunciation.ReefIPA = strings.Split(ReefMe(pronunciation.IPA, false)[1], "] or [")[0]
+
+			candidates = append(candidates, LoanwordCandidate{navi, []Pronunciation{pronunciation}, a.changes + changes})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Changes < candidates[j].Changes })
+	return
+}
+
+// Read the letters the way the language would, longest spelling first.
+// Each sound is the Na'vi phonemes it can become, best first, separated by spaces ("" means it's silent,
+// loanUnknown means there's nothing it could be)
+func loanSounds(word string, lang string) (sounds [][]string) {
+	rules := loanLanguages[lang]
+	if rules == nil {
+		rules = loanLanguages["en"]
+	}
+	lookup := func(letters string) ([]string, bool) {
+		if a, ok := rules[letters]; ok {
+			return a, true
+		}
+		a, ok := loanLetters[letters]
+		return a, ok
+	}
+
+	runes := []rune(word)
+	vowel := func(i int) bool {
+		return i < len(runes) && (is_vowel(runes[i]) || strings.ContainsRune("öüéèêëáàâíîïóôúû", runes[i]))
+	}
+
+	for i := 0; i < len(runes); i++ {
+		// Double letters are the same as single ones (unless the language says otherwise)
+		if i > 0 && runes[i] == runes[i-1] && !vowel(i) {
+			if _, ok := rules[string(runes[i-1:i+1])]; !ok {
+				continue
+			}
+		}
+
+		// Silent e at the end
+		if (lang == "en" || lang == "fr") && i == len(runes)-1 && runes[i] == 'e' && i > 1 && !vowel(i-1) &&
+			slices.ContainsFunc(runes[:i-1], is_vowel) {
+			continue
+		}
+
+		// Soft c and g
+		if (runes[i] == 'c' || runes[i] == 'g') && i+1 < len(runes) && strings.ContainsRune("eiy", runes[i+1]) {
+			switch {
+			case runes[i] == 'c':
+				sounds = append(sounds, []string{"s"})
+				continue
+			case lang == "es":
+				sounds = append(sounds, []string{"h"})
+				continue
+			case lang == "fr":
+				sounds = append(sounds, []string{"s y"})
+				continue
+			case lang != "de":
+				sounds = append(sounds, []string{"ts y", "k"})
+				continue
+			}
+		}
+
+		// y is a vowel unless a vowel comes after it
+		if runes[i] == 'y' && !vowel(i+1) {
+			sounds = append(sounds, []string{"i"})
+			continue
+		}
+
+		found := false
+		for length := min(4, len(runes)-i); length > 0; length-- {
+			if options, ok := lookup(string(runes[i : i+length])); ok {
+				sounds = append(sounds, options)
+				i += length - 1
+				found = true
+				break
+			}
+		}
+		if !found {
+			sounds = append(sounds, []string{loanUnknown})
+		}
+	}
+
+	return
+}
+
+func validLoanwordOnset(consonants []string) bool {
+	switch len(consonants) {
+	case 0, 1:
+		return true
+	case 2:
+		return slices.Contains(cluster_1, compress(consonants[0])) && slices.Contains(cluster_2, compress(consonants[1]))
+	}
+	return false
+}
+
+func validLoanwordCoda(consonant string) bool {
+	return consonant != "" && slices.Contains(letters_end, compress(consonant))
+}
+
+// Split consonants into onsets, keeping the longest onset at the end
+func loanwordOnsets(consonants []string) (onsets [][]string) {
+	for len(consonants) > 0 {
+		n := 1
+		if len(consonants) > 1 && validLoanwordOnset(consonants[len(consonants)-2:]) {
+			n = 2
+		}
+		onsets = slices.Insert(onsets, 0, consonants[len(consonants)-n:])
+		consonants = consonants[:len(consonants)-n]
+	}
+	return
+}
+
+// Fix consonants that can't go together, either with an extra ì (epenthesis) or by dropping them.
+// An extra vowel costs 2 and a dropped consonant costs 3
+func repairLoanword(phonemes []string, epenthesis bool) (repaired []string, changes int) {
+	// Split it into consonant runs around the nuclei.  The same consonant twice is just one (dt in Schmidt)
+	runs := [][]string{{}}
+	nuclei := []string{}
+	for i, a := range phonemes {
+		if i > 0 && a == phonemes[i-1] && !isNucleusPhoneme(a) {
+			continue
+		}
+		if isNucleusPhoneme(a) {
+			nuclei = append(nuclei, a)
+			runs = append(runs, []string{})
+		} else {
+			runs[len(runs)-1] = append(runs[len(runs)-1], a)
+		}
+	}
+
+	// Consonants, each group separated by an extra ì
+	epenthesize := func(onsets [][]string, last bool) {
+		for i, a := range onsets {
+			repaired = append(repaired, a...)
+			if i != len(onsets)-1 || last {
+				repaired = append(repaired, "ì")
+				changes += 2
+			}
+		}
+	}
+
+	for i, run := range runs {
+		first := i == 0
+		final := i == len(runs)-1
+
+		switch {
+		case first && len(nuclei) == 0:
+			// No vowels at all
+			if !epenthesis {
+				return nil, 0
+			}
+			epenthesize(loanwordOnsets(run), true)
+			continue
+		case first && validLoanwordOnset(run):
+			repaired = append(repaired, run...)
+		case final && (len(run) == 0 || len(run) == 1 && validLoanwordCoda(run[0])):
+			repaired = append(repaired, run...)
+		case !first && !final && (validLoanwordOnset(run) || validLoanwordCoda(run[0]) && validLoanwordOnset(run[1:])):
+			repaired = append(repaired, run...)
+		default:
+			// Keep a coda if there can be one
+			if !first && validLoanwordCoda(run[0]) {
+				repaired = append(repaired, run[0])
+				run = run[1:]
+			}
+			onsets := loanwordOnsets(run)
+			if epenthesis {
+				epenthesize(onsets, final)
+			} else {
+				if !final && len(onsets) > 0 {
+					repaired = append(repaired, onsets[len(onsets)-1]...)
+					run = run[:len(run)-len(onsets[len(onsets)-1])]
+				}
+				changes += 3 * len(run)
+			}
+		}
+
+		if !final {
+			repaired = append(repaired, nuclei[i])
+		}
+	}
+
+	return
+}
+
+// Where the stress goes, the way the source language would do it
+func loanwordStress(syllables []string, lang string) int {
+	switch lang {
+	case "fr":
+		return len(syllables)
+	case "es":
+		last := syllables[len(syllables)-1]
+		if len(syllables) > 1 && (is_vowel([]rune(last)[len([]rune(last))-1]) || strings.HasSuffix(last, "n") || strings.HasSuffix(last, "s")) {
+			return len(syllables) - 1
+		}
+		return len(syllables)
+	}
+	return 1
+}
@@ -0,0 +1,55 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAdaptLoanword(t *testing.T) {
+	tests := []struct {
+		input     string
+		lang      string
+		want      string
+		syllables []string
+		stressed  int
+	}{
+		{"Bob", "en", "pop", []string{"pop"}, 1},
+		{"computer", "en", "komputer", []string{"kom", "pu", "ter"}, 1},
+		{"Dragon", "en", "tìrakon", []string{"tì", "ra", "kon"}, 1},
+		{"Schmidt", "de", "syìmit", []string{"syì", "mit"}, 1},
+		{"Paella", "es", "paeya", []string{"pa", "e", "ya"}, 2},
+		{"Château", "fr", "syato", []string{"sya", "to"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := AdaptLoanword(tt.input, tt.lang)
+			if err != nil {
+				t.Fatalf("AdaptLoanword() error = %v", err)
+			}
+			if got[0].Navi != tt.want {
+				t.Errorf("AdaptLoanword() = %v, want %v", got[0].Navi, tt.want)
+			}
+			if !reflect.DeepEqual(got[0].Words[0].Syllables, tt.syllables) || got[0].Words[0].Stressed != tt.stressed {
+				t.Errorf("AdaptLoanword() syllables = %v/%d, want %v/%d", got[0].Words[0].Syllables, got[0].Words[0].Stressed, tt.syllables, tt.stressed)
+			}
+			for _, a := range got {
+				if ValidateNavi(a.Navi).Verdict == VerdictInvalid {
+					t.Errorf("AdaptLoanword() gave invalid %v", a.Navi)
+				}
+			}
+		})
+	}
+
+	got, err := AdaptLoanword("Jake Sully", "en")
+	if err != nil || got[0].Navi != "tsyak suli" || len(got[0].Words) != 2 {
+		t.Errorf("AdaptLoanword() = %v, %v", got, err)
+	}
+	// ł and ź are dropped, and that isn't free
+	got, err = AdaptLoanword("Łódź", "en")
+	if err != nil || got[0].Navi != "ot" || got[0].Changes < 6 {
+		t.Errorf("AdaptLoanword() = %v, %v, want ot with at least 6 changes", got, err)
+	}
+	if _, err = AdaptLoanword("!!", "en"); err == nil {
+		t.Errorf("AdaptLoanword() expected an error")
+	}
+}
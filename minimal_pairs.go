@@ -0,0 +1,206 @@
+package fwew_lib
+
+import (
+	"slices"
+	"strings"
+)
+
+// Two dictionary words that differ by exactly one phoneme
+type MinimalPair struct {
+	First    Word
+	Second   Word
+	Contrast [2]string // The phonemes that differ, in the same order as the words
+	Position int       // Which phoneme differs (starting at 0)
+}
+
+// Every phoneme a contrast can have
+var contrastPhonemes = []string{
+	"'", "f", "h", "k", "kx", "l", "m", "n", "ng", "p", "px", "r", "s", "t", "ts", "tx", "v", "w", "y", "z",
+	"a", "ä", "e", "i", "ì", "o", "u", "ù", "aw", "ay", "ew", "ey", "ll", "rr",
+}
+
+// Find every minimal pair in the dictionary.  Digraphs count as one phoneme, so tx and t are a contrast
+// but tx and txa are not.  If contrast has two phonemes (like "ì" and "i"), only pairs with that contrast return.
+// A contrast that isn't nil or two phonemes is InvalidNavi
+func MinimalPairs(contrast []string) (results []MinimalPair, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	var wanted [2]string
+	if contrast != nil {
+		if len(contrast) != 2 {
+			return nil, InvalidNavi
+		}
+		for i, a := range contrast {
+			a = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(a)), "’", "'")
+			if !ContainsStr(contrastPhonemes, a) {
+				return nil, InvalidNavi
+			}
+			wanted[i] = compress(a)
+		}
+	}
+
+	buckets, _, words, err := phonemeIndex()
+	if err != nil {
+		return
+	}
+
+	keys := []string{}
+	for key := range buckets {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		bucket := buckets[key]
+		position := slices.Index(strings.Split(key, "."), "*")
+		for i := 0; i < len(bucket); i++ {
+			for j := i + 1; j < len(bucket); j++ {
+				a := words[bucket[i]]
+				b := words[bucket[j]]
+				first := a.units[position]
+				second := b.units[position]
+				if first == second || first == " " || second == " " {
+					continue
+				}
+				if contrast != nil && !(wanted == [2]string{first, second} || wanted == [2]string{second, first}) {
+					continue
+				}
+				results = append(results, MinimalPair{
+					First:    a.word,
+					Second:   b.word,
+					Contrast: [2]string{decompress(first), decompress(second)},
+					Position: position,
+				})
+			}
+		}
+	}
+
+	if len(results) == 0 {
+		err = NoResults
+	}
+
+	return
+}
+
+// Find all dictionary words one phoneme away from the input, by changing, adding or removing one phoneme
+func PhonologicalNeighbours(input string) (results []Word, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	input = clean(input)
+	if len(input) == 0 {
+		return results, NoResults
+	}
+
+	buckets, exact, words, err := phonemeIndex()
+	if err != nil {
+		return
+	}
+
+	results = phonologicalNeighbours(input, buckets, exact, words)
+	if len(results) == 0 {
+		err = NoResults
+	}
+
+	return
+}
+
+func phonologicalNeighbours(input string, buckets map[string][]int, exact map[string][]int, words []phonemeWord) (results []Word) {
+	units := phonemeUnits(input)
+	found := map[int]bool{}
+	add := func(indices []int) {
+		for _, a := range indices {
+			if !found[a] {
+				found[a] = true
+				results = append(results, words[a].word)
+			}
+		}
+	}
+
+	for i := range units {
+		// Change one phoneme
+		changed := slices.Clone(units)
+		changed[i] = "*"
+		for _, a := range buckets[strings.Join(changed, ".")] {
+			if words[a].units[i] != units[i] && words[a].units[i] != " " && units[i] != " " {
+				add([]int{a})
+			}
+		}
+		// Remove one phoneme
+		if units[i] != " " {
+			add(exact[strings.Join(slices.Delete(slices.Clone(units), i, i+1), ".")])
+		}
+	}
+
+	// Add one phoneme
+	for i := 0; i <= len(units); i++ {
+		added := slices.Insert(slices.Clone(units), i, "*")
+		for _, a := range buckets[strings.Join(added, ".")] {
+			if words[a].units[i] != " " {
+				add([]int{a})
+			}
+		}
+	}
+
+	return
+}
+
+type phonemeWord struct {
+	word  Word
+	units []string
+}
+
+// The phonemes of a word, each compressed to a single character
+func phonemeUnits(navi string) (units []string) {
+	for _, a := range splitPhonemes(navi) {
+		units = append(units, compress(a))
+	}
+	return
+}
+
+type phonemeBuckets struct {
+	buckets map[string][]int
+	exact   map[string][]int
+	words   []phonemeWord
+}
+
+// The phoneme buckets, made the first time they're used
+func phonemeIndex() (buckets map[string][]int, exact map[string][]int, words []phonemeWord, err error) {
+	index := derivedIndex("phonemes", func() (index phonemeBuckets, keep bool) {
+		index.buckets, index.exact, index.words, err = newPhonemeIndex()
+		// Don't remember an empty dictionary, it might not be loaded yet
+		return index, err == nil && len(index.words) > 0
+	})
+	return index.buckets, index.exact, index.words, err
+}
+
+// Put every word in a bucket for each of its phonemes blanked out.  Words in the same bucket differ by that phoneme
+func newPhonemeIndex() (buckets map[string][]int, exact map[string][]int, words []phonemeWord, err error) {
+	buckets = map[string][]int{}
+	exact = map[string][]int{}
+
+	err = RunOnDict(func(word Word) error {
+		syllables := strings.ToLower(strings.Split(word.Syllables, " or ")[0])
+		units := []string{}
+		for _, a := range strings.Split(syllables, "-") {
+			units = append(units, phonemeUnits(a)...)
+		}
+		if len(units) == 0 {
+			return nil
+		}
+
+		index := len(words)
+		words = append(words, phonemeWord{word, units})
+		exact[strings.Join(units, ".")] = append(exact[strings.Join(units, ".")], index)
+		for i := range units {
+			blanked := slices.Clone(units)
+			blanked[i] = "*"
+			key := strings.Join(blanked, ".")
+			buckets[key] = append(buckets[key], index)
+		}
+		return nil
+	})
+
+	return
+}
@@ -0,0 +1,92 @@
+package fwew_lib
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestMinimalPairs(t *testing.T) {
+	CacheDictHash()
+	tests := []struct {
+		name     string
+		contrast []string
+		want     [2]string // a pair that has to be found, if there is one
+		wantErr  error
+	}{
+		{"t ts", []string{"t", "ts"}, [2]string{"taw", "tsaw"}, nil},
+		{"ts t", []string{"ts", "t"}, [2]string{"taw", "tsaw"}, nil},
+		{"tx t", []string{"tx", "t"}, [2]string{}, nil},
+		{"ì i", []string{"ì", "i"}, [2]string{}, nil},
+		{"not a phoneme", []string{"q", "k"}, [2]string{}, InvalidNavi},
+		{"two phonemes", []string{"tx", "txa"}, [2]string{}, InvalidNavi},
+		{"one phoneme", []string{"t"}, [2]string{}, InvalidNavi},
+		{"three phonemes", []string{"t", "ts", "tx"}, [2]string{}, InvalidNavi},
+		{"empty", []string{}, [2]string{}, InvalidNavi},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MinimalPairs(tt.contrast)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("MinimalPairs() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil && (!errors.Is(err, NoResults) || len(tt.want[0]) > 0) {
+				t.Fatalf("MinimalPairs() error = %v", err)
+			}
+			found := false
+			for _, a := range got {
+				contrast := []string{a.Contrast[0], a.Contrast[1]}
+				if !slices.Contains(contrast, tt.contrast[0]) || !slices.Contains(contrast, tt.contrast[1]) {
+					t.Errorf("MinimalPairs() %s %s has contrast %v, want %v", a.First.Navi, a.Second.Navi, a.Contrast, tt.contrast)
+				}
+				if [2]string{a.First.Navi, a.Second.Navi} == tt.want || [2]string{a.Second.Navi, a.First.Navi} == tt.want {
+					found = true
+				}
+			}
+			if len(tt.want[0]) > 0 && !found {
+				t.Errorf("MinimalPairs() didn't find %v", tt.want)
+			}
+		})
+	}
+	UncacheHashDict()
+}
+
+func TestPhonologicalNeighbours(t *testing.T) {
+	CacheDictHash()
+	tests := []struct {
+		input   string
+		want    []string
+		notWant []string
+	}{
+		{"txaw", []string{"taw", "tsaw", "'aw"}, []string{}},
+		{"mi", []string{"mì"}, []string{}},
+		{"tsaw", []string{"taw", "'aw"}, []string{"tsaw"}},
+		{"taron", []string{}, []string{"taron", "taronyu", "tìtaron"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := PhonologicalNeighbours(tt.input)
+			if err != nil && (!errors.Is(err, NoResults) || len(tt.want) > 0) {
+				t.Fatalf("PhonologicalNeighbours() error = %v", err)
+			}
+			navi := []string{}
+			for _, a := range got {
+				navi = append(navi, a.Navi)
+			}
+			for _, a := range tt.want {
+				if !slices.Contains(navi, a) {
+					t.Errorf("PhonologicalNeighbours() = %v, want %s in it", navi, a)
+				}
+			}
+			for _, a := range tt.notWant {
+				if slices.Contains(navi, a) {
+					t.Errorf("PhonologicalNeighbours() = %v, don't want %s in it", navi, a)
+				}
+			}
+		})
+	}
+	UncacheHashDict()
+}
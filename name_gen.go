@@ -219,16 +219,14 @@ func NameAlu(name_count int, dialect int, syllable_count int, noun_mode int, adj
 				adj = strings.ReplaceAll(adj, "-", "")
 
 				// If the adj starts with a in forest, we don't need another a
-				if !two_word_noun && (strings.ToLower(string(adj[0])) != "a" || dialect != 1) {
-					if (adj[:2] == "le" && adj != "ler" && adj != "leyr" && adj != "lewnga'") || adj == "lafyon" {
-						adj = glottal_caps(adj) // le-adjectives
+				if needsAttributiveA(adj, !two_word_noun, Dialect(dialect)) {
+					if two_word_noun {
+						adj = glottal_caps(adj) + "a"
 					} else {
 						adj = "a" + glottal_caps(adj)
 					}
-				} else if two_word_noun && (adj[len(adj)-1] != 'a' || dialect != 1) {
-					adj = glottal_caps(adj) + "a"
 				} else {
-					adj = glottal_caps(adj) // forest dialect a-adjectives like axpa or alaksi
+					adj = glottal_caps(adj) // le-adjectives and forest dialect a-adjectives like axpa or alaksi
 				}
 			case 3: //genitive noun
 				adj_word := fast_random(allNouns)
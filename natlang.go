@@ -0,0 +1,179 @@
+package fwew_lib
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Letters with diacritics and what they fold to
+var natlangFolds = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ã", "a", "ä", "a", "å", "a", "ą", "a",
+	"ç", "c", "ć", "c", "č", "c",
+	"è", "e", "é", "e", "ê", "e", "ë", "e", "ę", "e", "ě", "e",
+	"ì", "i", "í", "i", "î", "i", "ï", "i",
+	"ł", "l", "ñ", "n", "ń", "n",
+	"ò", "o", "ó", "o", "ô", "o", "õ", "o", "ö", "o", "ő", "o",
+	"ś", "s", "š", "s", "ß", "ss",
+	"ù", "u", "ú", "u", "û", "u", "ü", "u", "ű", "u",
+	"ý", "y", "ÿ", "y", "ź", "z", "ż", "z", "ž", "z",
+	"ё", "е", "й", "и",
+)
+
+// Plural endings come off first, then one of the other endings.  Longest first
+var natlangPlurals = map[string][]string{
+	"en": {"es", "s"},
+	"de": {"en", "er", "e", "n", "s"},
+	"es": {"es", "s"},
+	"fr": {"s", "x"},
+	"ru": {"ами", "ями", "ах", "ях", "ов", "ев", "ей", "ы", "и"},
+	"pl": {"ami", "ach", "owie", "ów", "om", "y", "i"},
+}
+
+var natlangEndings = map[string][]string{
+	"en": {"ing", "ed", "e"},
+	"de": {"ung", "est", "et", "te", "st", "em", "es", "e", "t"},
+	"es": {"iendo", "ando", "ado", "ido", "ar", "er", "ir", "a", "o", "e"},
+	"fr": {"ement", "ant", "er", "ir", "re", "ez", "ée", "é", "e"},
+	"ru": {"ать", "ять", "еть", "ить", "ешь", "ет", "ут", "ют", "ит", "ат", "ят",
+		"ом", "ем", "ой", "ий", "ый", "ая", "яя", "ое", "ее", "ые", "ие", "ть",
+		"а", "я", "о", "е", "у", "ю"},
+	"pl": {"ować", "ać", "ić", "yć", "eć", "em", "ie", "a", "e", "u", "ę", "ą", "o"},
+}
+
+// Lowercase, stem and fold a word of a natural language, so that "running" and "run"
+// or "Bäume" and "Baum" come out the same.  Languages without a stemmer are just folded
+func NormalizeNatlang(word string, langCode string) string {
+	word = strings.ToLower(word)
+	if langCode == "en" && strings.HasSuffix(word, "ies") && utf8.RuneCountInString(word) > 4 {
+		word = strings.TrimSuffix(word, "ies") + "y"
+	}
+	word = natlangTrim(word, natlangPlurals[langCode])
+	before := word
+	word = natlangTrim(word, natlangEndings[langCode])
+
+	// runn(ing) is run
+	if langCode == "en" && word != before {
+		runes := []rune(word)
+		if n := len(runes); n > 3 && runes[n-1] == runes[n-2] && !strings.ContainsRune("aeiouylsz", runes[n-1]) {
+			word = string(runes[:n-1])
+		}
+	}
+
+	// A soft sign left at the end doesn't change the word
+	if langCode == "ru" {
+		word = strings.TrimSuffix(word, "ь")
+	}
+
+	return natlangFolds.Replace(word)
+}
+
+// Take off the first ending that leaves at least three letters
+func natlangTrim(word string, endings []string) string {
+	for _, a := range endings {
+		if strings.HasSuffix(word, a) && utf8.RuneCountInString(word)-utf8.RuneCountInString(a) >= 3 {
+			return strings.TrimSuffix(word, a)
+		}
+	}
+	return word
+}
+
+// Helper function for CacheDictHash2.  Like AssignWord, but with the normalized terms
+func AssignNormalizedWord(wordmap map[string][]string, natlangWords string, naviWord string, langCode string) map[string][]string {
+	for _, a := range SearchTerms(natlangWords, false) {
+		if len(a) == 0 {
+			continue
+		}
+		a = NormalizeNatlang(a, langCode)
+		if !ContainsStr(wordmap[a], naviWord) {
+			wordmap[a] = append(wordmap[a], naviWord)
+		}
+	}
+	return wordmap
+}
+
+// The map for one language
+func (m *MetaDict) lang(langCode string) map[string][]string {
+	switch langCode {
+	case "de":
+		return m.DE
+	case "es":
+		return m.ES
+	case "et":
+		return m.ET
+	case "fr":
+		return m.FR
+	case "hu":
+		return m.HU
+	case "it":
+		return m.IT
+	case "ko":
+		return m.KO
+	case "nl":
+		return m.NL
+	case "pl":
+		return m.PL
+	case "pt":
+		return m.PT
+	case "ru":
+		return m.RU
+	case "sv":
+		return m.SV
+	case "tr":
+		return m.TR
+	case "uk":
+		return m.UK
+	}
+	return m.EN
+}
+
+// Words with a definition that has the search word in another form, like "trees" for "tree"
+func TranslateToNaviNormalizedHelper(searchWord string, langCode string) (results []Word) {
+	normalized := NormalizeNatlang(searchWord, langCode)
+	for _, a := range dictHash2Normalized.lang(langCode)[normalized] {
+		for _, b := range dictHashStrict[a] {
+			// Homonyms have their own definitions
+			for _, c := range SearchTerms(definitionOf(b, langCode), false) {
+				if NormalizeNatlang(c, langCode) == normalized {
+					results = AppendAndAlphabetize(results, b)
+					break
+				}
+			}
+		}
+	}
+	return
+}
+
+// The definition in a language
+func definitionOf(word Word, langCode string) string {
+	switch langCode {
+	case "de":
+		return word.DE
+	case "es":
+		return word.ES
+	case "et":
+		return word.ET
+	case "fr":
+		return word.FR
+	case "hu":
+		return word.HU
+	case "it":
+		return word.IT
+	case "ko":
+		return word.KO
+	case "nl":
+		return word.NL
+	case "pl":
+		return word.PL
+	case "pt":
+		return word.PT
+	case "ru":
+		return word.RU
+	case "sv":
+		return word.SV
+	case "tr":
+		return word.TR
+	case "uk":
+		return word.UK
+	}
+	return word.EN
+}
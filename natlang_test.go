@@ -0,0 +1,61 @@
+package fwew_lib
+
+import (
+	"testing"
+)
+
+func TestNormalizeNatlang(t *testing.T) {
+	tests := []struct {
+		word, lang, other string
+	}{
+		{"running", "en", "run"},
+		{"trees", "en", "tree"},
+		{"stories", "en", "story"},
+		{"hunted", "en", "hunting"},
+		{"Bäume", "de", "Baum"},
+		{"Tiere", "de", "Tier"},
+		{"árboles", "es", "árbol"},
+		{"corriendo", "es", "correr"},
+		{"arbres", "fr", "arbre"},
+		{"деревьями", "ru", "деревья"},
+		{"drzewami", "pl", "drzewa"},
+		{"Vogel", "nl", "vogel"},
+	}
+	for _, tt := range tests {
+		if NormalizeNatlang(tt.word, tt.lang) != NormalizeNatlang(tt.other, tt.lang) {
+			t.Errorf("NormalizeNatlang(%q) = %q, NormalizeNatlang(%q) = %q, want the same", tt.word,
+				NormalizeNatlang(tt.word, tt.lang), tt.other, NormalizeNatlang(tt.other, tt.lang))
+		}
+	}
+
+	if NormalizeNatlang("tree", "en") == NormalizeNatlang("three", "en") {
+		t.Errorf("NormalizeNatlang() mixes up tree and three")
+	}
+}
+
+func TestTranslateToNaviNormalized(t *testing.T) {
+	CacheDictHash()
+	CacheDictHash2()
+	tests := []struct {
+		query, lang string
+		want        []string
+	}{
+		{"running", "en", []string{"tul"}},
+		{"Bäume", "de", []string{"utral"}},
+		// The exact match comes first
+		{"hunt", "en", []string{"taron", "tìtaron"}},
+	}
+	for _, tt := range tests {
+		got := TranslateToNaviHash(tt.query, tt.lang)
+		if len(got) != 1 || len(got[0]) != len(tt.want)+1 {
+			t.Errorf("TranslateToNaviHash(%q) = %v, want %v", tt.query, got, tt.want)
+			continue
+		}
+		for i, a := range tt.want {
+			if got[0][i+1].Navi != a {
+				t.Errorf("TranslateToNaviHash(%q) = %v, want %v", tt.query, got[0][1:], tt.want)
+				break
+			}
+		}
+	}
+}
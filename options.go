@@ -0,0 +1,217 @@
+package fwew_lib
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Same numbers as the dialect codes everywhere else
+type Dialect int
+
+const (
+	DialectBoth Dialect = iota
+	DialectForest
+	DialectReef
+)
+
+// Options for the searches, List and the output.  The zero value searches both dialects without
+// checking for affixes, in English, with no limit.  Every function only uses the options it needs
+type SearchOptions struct {
+	Dialect    Dialect // Forest only finds forest words.  Reef and Both find the same words, but Reef shows the reef pronunciation in the output
+	Strict     bool    // a doesn't find ä and i doesn't find ì
+	CheckFixes bool    // find conjugated words
+	Lang       string  // definitions language.  "" is English
+	MaxResults int     // most words per search (after the query), 0 for all
+	Explain    bool    // put how each conjugated word was found in its Affixes.Comment
+
+	CheckDigraphs uint8 // for List
+
+	// For the output
+	Markdown      bool
+	ShowIPA       bool
+	ShowInfixes   bool
+	ShowDashed    bool
+	ShowInfixDots bool
+	ShowSource    bool
+}
+
+func searchDialect(allowReef bool) Dialect {
+	if allowReef {
+		return DialectBoth
+	}
+	return DialectForest
+}
+
+func (o SearchOptions) allowReef() bool {
+	return o.Dialect != DialectForest
+}
+
+func (o SearchOptions) lang() string {
+	if o.Lang == "" {
+		return "en"
+	}
+	return o.Lang
+}
+
+// MaxResults and Explain, for results with the query at the start of every group
+func (o SearchOptions) finish(results [][]Word) [][]Word {
+	for i, a := range results {
+		if o.MaxResults > 0 && len(a) > o.MaxResults+1 {
+			results[i] = a[:o.MaxResults+1]
+		}
+		if o.Explain {
+			for j := 1; j < len(results[i]); j++ {
+				results[i][j] = explainWord(results[i][j])
+			}
+		}
+	}
+	return results
+}
+
+// Say which affixes were taken off to find a word
+func explainWord(w Word) Word {
+	parts := []string{}
+	if len(w.Affixes.Prefix) > 0 {
+		parts = append(parts, fmt.Sprintf("prefixes %s-", strings.Join(w.Affixes.Prefix, "- ")))
+	}
+	if len(w.Affixes.Lenition) > 0 {
+		parts = append(parts, "lenition "+strings.Join(w.Affixes.Lenition, ", "))
+	}
+	if len(w.Affixes.Infix) > 0 {
+		parts = append(parts, fmt.Sprintf("infixes <%s>", strings.Join(w.Affixes.Infix, "> <")))
+	}
+	if len(w.Affixes.Suffix) > 0 {
+		parts = append(parts, fmt.Sprintf("suffixes -%s", strings.Join(w.Affixes.Suffix, " -")))
+	}
+	if len(parts) == 0 {
+		return w
+	}
+
+	w.Affixes.Comment = append(append([]string{}, w.Affixes.Comment...), fmt.Sprintf("%s with %s", w.Navi, strings.Join(parts, ", ")))
+	return w
+}
+
+// TranslateFromNaviHash with named options
+func TranslateFromNaviWith(searchNaviWords string, options SearchOptions) (results [][]Word, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+	results, err = translateFromNaviHash(searchNaviWords, options.CheckFixes, options.Strict, options.allowReef())
+	return options.finish(results), err
+}
+
+// TranslateToNaviHash with named options
+func TranslateToNaviWith(searchWord string, options SearchOptions) (results [][]Word) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+	return options.finish(translateToNaviHash(searchWord, options.lang()))
+}
+
+// BidirectionalSearch with named options
+func BidirectionalSearchWith(searchNaviWords string, options SearchOptions) (results [][]Word, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+	results, err = bidirectionalSearch(searchNaviWords, options.CheckFixes, options.Strict, options.lang(), options.allowReef())
+	return options.finish(results), err
+}
+
+// MaxResults and Explain, for SearchResults
+func (o SearchOptions) finishSearch(results []SearchResult) []SearchResult {
+	for i, a := range results {
+		if o.MaxResults > 0 && len(a.Words) > o.MaxResults {
+			results[i].Words = a.Words[:o.MaxResults]
+		}
+		if o.Explain {
+			for j := range results[i].Words {
+				results[i].Words[j] = explainWord(results[i].Words[j])
+			}
+		}
+	}
+	return results
+}
+
+// SearchNavi with named options.  Lang is for the compound glosses
+func SearchNaviWith(text string, options SearchOptions) (results []SearchResult, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+	results, err = searchNavi(text, options.CheckFixes, options.Strict, options.allowReef(), options.lang())
+	return options.finishSearch(results), err
+}
+
+// SearchNatlang with named options
+func SearchNatlangWith(text string, options SearchOptions) (results []SearchResult) {
+	return options.finishSearch(SearchNatlang(text, options.lang()))
+}
+
+// TranslateBatch with named options, with one worker per CPU
+func TranslateBatchWith(inputs []string, options SearchOptions) (results []BatchResult) {
+	results = TranslateBatch(inputs, BatchOptions{CheckFixes: options.CheckFixes, Strict: options.Strict, AllowReef: options.allowReef()})
+	for i := range results {
+		results[i].Results = options.finish(results[i].Results)
+	}
+	return
+}
+
+// TranslateFromNaviTokens with named options
+func TranslateFromNaviTokensWith(tokens []SentenceToken, options SearchOptions) (results [][]Word, err error) {
+	results, err = TranslateFromNaviTokens(tokens, options.CheckFixes, options.Strict, options.allowReef())
+	return options.finish(results), err
+}
+
+// Autocomplete with named options.  MaxResults is the limit.  Lang "navi" suggests Na'vi words,
+// and an empty Lang is English like everywhere else
+func AutocompleteWith(prefix string, options SearchOptions) (results []string) {
+	return Autocomplete(prefix, options.lang(), options.MaxResults)
+}
+
+// SearchDefinitions with named options.  MaxResults is the limit
+func SearchDefinitionsWith(query string, options SearchOptions) (hits []DefinitionHit, err error) {
+	return SearchDefinitions(query, options.lang(), options.MaxResults)
+}
+
+// Rhymes with named options.  DialectReef compares the reef pronunciations, and MaxResults is the limit for each group
+func RhymesWith(word string, options SearchOptions) (results RhymeResults, err error) {
+	return Rhymes(word, RhymeOptions{Reef: options.Dialect == DialectReef, Limit: options.MaxResults})
+}
+
+// SpellCheck with named options.  MaxResults is the most suggestions for each word
+func SpellCheckWith(text string, options SearchOptions) (tokens []SpellToken, err error) {
+	return SpellCheck(text, SpellCheckOptions{AllowReef: options.allowReef(), MaxSuggestions: options.MaxResults})
+}
+
+// ConvertDialect with named options.  The text is converted to options.Dialect
+func ConvertDialectWith(text string, from Dialect, options SearchOptions) (result DialectConversion, err error) {
+	return ConvertDialect(text, from, options.Dialect)
+}
+
+// TestDeconjugations with named options.  An ä in the word means a reef e could be an ä,
+// so give it the word the way it was typed
+func TestDeconjugationsWith(dict *map[string][]Word, searchNaviWord string, options SearchOptions) (results []Word) {
+	umlaut := strings.Contains(strings.ToLower(searchNaviWord), "ä")
+	results = TestDeconjugations(dict, searchNaviWord, options.Strict, options.allowReef(), umlaut)
+	if options.MaxResults > 0 && len(results) > options.MaxResults {
+		results = results[:options.MaxResults]
+	}
+	if options.Explain {
+		for i := range results {
+			results[i] = explainWord(results[i])
+		}
+	}
+	return
+}
+
+// List with named options.  MaxResults is the most words it gives
+func ListWith(args []string, options SearchOptions) (results []Word, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+	results, err = list(args, options.CheckDigraphs)
+	if options.MaxResults > 0 && len(results) > options.MaxResults {
+		results = results[:options.MaxResults]
+	}
+	return
+}
+
+// ToOutputLine with named options
+func (w *Word) ToOutputLineWith(i string, options SearchOptions) (output string, err error) {
+	return w.toOutputLine(i, options.Markdown, options.ShowIPA, options.ShowInfixes, options.ShowDashed,
+		options.ShowInfixDots, options.ShowSource, options.Dialect == DialectReef, options.lang())
+}
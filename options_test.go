@@ -0,0 +1,144 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTranslateFromNaviWith(t *testing.T) {
+	CacheDictHash()
+	want, err := TranslateFromNaviHash("fìtsmukanit taronyutsyìp", true, false, false)
+	if err != nil {
+		t.Fatalf("TranslateFromNaviHash() error = %v", err)
+	}
+	got, err := TranslateFromNaviWith("fìtsmukanit taronyutsyìp", SearchOptions{Dialect: DialectForest, CheckFixes: true})
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("TranslateFromNaviWith() = %v, %v, want %v", got, err, want)
+	}
+
+	got, _ = TranslateFromNaviWith("fìtsmukanit taronyutsyìp", SearchOptions{Dialect: DialectForest, CheckFixes: true, MaxResults: 1, Explain: true})
+	if len(got) != 2 || len(got[0]) != 2 || len(got[1]) != 2 {
+		t.Fatalf("TranslateFromNaviWith() = %v, want one word each", got)
+	}
+	comment := got[0][1].Affixes.Comment
+	if len(comment) != 1 || comment[0] != "tsmukan with prefixes fì-, suffixes -it" {
+		t.Errorf("TranslateFromNaviWith() comment = %q", comment)
+	}
+	if len(want[0][1].Affixes.Comment) != 0 {
+		t.Errorf("TranslateFromNaviWith() changed the words without Explain")
+	}
+}
+
+func TestTranslateToNaviWith(t *testing.T) {
+	CacheDictHash()
+	CacheDictHash2()
+	got := TranslateToNaviWith("Baum", SearchOptions{Lang: "de"})
+	if !reflect.DeepEqual(got, TranslateToNaviHash("Baum", "de")) || len(got[0]) != 2 {
+		t.Errorf("TranslateToNaviWith() = %v", got)
+	}
+	got = TranslateToNaviWith("sky", SearchOptions{MaxResults: 1})
+	if len(got[0]) != 2 {
+		t.Errorf("TranslateToNaviWith() = %v, want one word", got)
+	}
+}
+
+func TestBidirectionalSearchWithStrict(t *testing.T) {
+	CacheDictHash()
+	CacheDictHash2()
+	got, err := BidirectionalSearchWith("kaltxi", SearchOptions{CheckFixes: true})
+	if err != nil || len(got) != 1 || len(got[0]) != 2 || got[0][1].Navi != "kaltxì" {
+		t.Errorf("BidirectionalSearchWith() = %v, %v, want kaltxì", got, err)
+	}
+	got, err = BidirectionalSearchWith("kaltxi", SearchOptions{CheckFixes: true, Strict: true})
+	if err != nil || len(got) != 1 || len(got[0]) != 1 {
+		t.Errorf("BidirectionalSearchWith() strict = %v, %v, want no kaltxì", got, err)
+	}
+}
+
+func TestListWith(t *testing.T) {
+	got, err := ListWith([]string{"pos", "is", "n."}, SearchOptions{MaxResults: 3})
+	if err != nil || len(got) != 3 {
+		t.Errorf("ListWith() = %v, %v, want 3 words", got, err)
+	}
+}
+
+func TestToOutputLineWith(t *testing.T) {
+	CacheDictHash()
+	results, _ := TranslateFromNaviHash("tute", true, false, false)
+	w := results[0][1]
+	want, _ := w.ToOutputLine("1", true, true, false, true, false, true, true, "de")
+	got, err := w.ToOutputLineWith("1", SearchOptions{Markdown: true, ShowIPA: true, ShowDashed: true, ShowSource: true, Dialect: DialectReef, Lang: "de"})
+	if err != nil || got != want {
+		t.Errorf("ToOutputLineWith() = %q, want %q", got, want)
+	}
+}
+
+func TestToOutputLineWithDefaultLang(t *testing.T) {
+	CacheDictHash()
+	results, _ := TranslateFromNaviHash("tute", true, false, false)
+	w := results[0][1]
+	want, _ := w.ToOutputLine("1", false, false, false, false, false, false, false, "en")
+	got, err := w.ToOutputLineWith("1", SearchOptions{})
+	if err != nil || got != want {
+		t.Errorf("ToOutputLineWith() = %q, want %q", got, want)
+	}
+}
+
+func TestTestDeconjugationsWith(t *testing.T) {
+	CacheDictHash()
+	want := TestDeconjugations(&dictHashStrict, "taronyut", true, false, false)
+	got := TestDeconjugationsWith(&dictHashStrict, "taronyut", SearchOptions{Dialect: DialectForest, Strict: true})
+	if len(want) == 0 || !reflect.DeepEqual(got, want) {
+		t.Errorf("TestDeconjugationsWith() = %v, want %v", got, want)
+	}
+
+	got = TestDeconjugationsWith(&dictHashStrict, "taronyut", SearchOptions{Dialect: DialectForest, Strict: true, MaxResults: 1, Explain: true})
+	if len(got) != 1 || len(got[0].Affixes.Comment) != 1 {
+		t.Errorf("TestDeconjugationsWith() = %v, want one explained word", got)
+	}
+}
+
+func TestFeaturesWith(t *testing.T) {
+	CacheDictHash()
+	CacheDictHash2()
+
+	batch := TranslateBatchWith([]string{"taronyut", "tute"}, SearchOptions{Dialect: DialectForest, CheckFixes: true})
+	if want := TranslateBatch([]string{"taronyut", "tute"}, BatchOptions{CheckFixes: true}); !reflect.DeepEqual(batch, want) {
+		t.Errorf("TranslateBatchWith() = %v, want %v", batch, want)
+	}
+
+	natlang := SearchNatlangWith("sky", SearchOptions{MaxResults: 1})
+	if len(natlang) != 1 || len(natlang[0].Words) != 1 {
+		t.Errorf("SearchNatlangWith() = %v, want one word", natlang)
+	}
+
+	tokens := TokenizeSentence("Taronyut tute.")
+	words, err := TranslateFromNaviTokensWith(tokens, SearchOptions{Dialect: DialectForest, CheckFixes: true, Explain: true})
+	if err != nil || len(words[0]) < 2 || len(words[0][1].Affixes.Comment) != 1 {
+		t.Errorf("TranslateFromNaviTokensWith() = %v, %v, want taronyut explained", words, err)
+	}
+
+	if got, want := AutocompleteWith("tar", SearchOptions{Lang: "navi", MaxResults: 2}), Autocomplete("tar", "navi", 2); !reflect.DeepEqual(got, want) {
+		t.Errorf("AutocompleteWith() = %v, want %v", got, want)
+	}
+
+	hits, err := SearchDefinitionsWith("hunting", SearchOptions{MaxResults: 1})
+	if want, _ := SearchDefinitions("hunting", "en", 1); err != nil || !reflect.DeepEqual(hits, want) {
+		t.Errorf("SearchDefinitionsWith() = %v, %v, want %v", hits, err, want)
+	}
+
+	rhymes, err := RhymesWith("taron", SearchOptions{Dialect: DialectReef, MaxResults: 1})
+	if want, _ := Rhymes("taron", RhymeOptions{Reef: true, Limit: 1}); err != nil || !reflect.DeepEqual(rhymes, want) {
+		t.Errorf("RhymesWith() = %v, %v, want %v", rhymes, err, want)
+	}
+
+	spelling, err := SpellCheckWith("kaltxi", SearchOptions{Dialect: DialectForest, MaxResults: 1})
+	if err != nil || len(spelling) != 1 || len(spelling[0].Suggestions) > 1 {
+		t.Errorf("SpellCheckWith() = %v, %v, want at most one suggestion", spelling, err)
+	}
+
+	converted, err := ConvertDialectWith("kaltxì", DialectForest, SearchOptions{Dialect: DialectReef})
+	if err != nil || converted.Text != "kaldì" {
+		t.Errorf("ConvertDialectWith() = %v, %v, want kaldì", converted, err)
+	}
+}
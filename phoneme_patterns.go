@@ -0,0 +1,252 @@
+package fwew_lib
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// A compiled phoneme pattern.  There are two kinds:
+//
+//	C V C . C V          C is any consonant, V is any vowel, diphthong or psuedovowel,
+//	[ejective] V *       [class] is a phoneme class, . is a syllable boundary, * is anything,
+//	tx V                 and lowercase letters are the phonemes themselves
+//
+//	syllable 2 has coda  syllable <n> has [no] <onset|coda|cluster|class|phoneme>
+//	syllable -1 is C V   syllable <n> is <pattern of the first kind>
+//
+// Without any "." in it, a pattern ignores syllable boundaries
+type PhonemePattern struct {
+	elements   []patternElement
+	boundaries bool
+
+	// syllable statements
+	syllable int
+	feature  string
+	negate   bool
+	sub      *PhonemePattern
+}
+
+type patternElement struct {
+	kind     int      // patternPhoneme, patternBoundary or patternAnything
+	phonemes []string // what a patternPhoneme can match
+}
+
+const (
+	patternPhoneme = iota
+	patternBoundary
+	patternAnything
+)
+
+// Turn a pattern string into something Match() can use
+func CompilePhonemePattern(pattern string) (compiled *PhonemePattern, err error) {
+	fields := strings.Fields(pattern)
+	if len(fields) == 0 {
+		return nil, InvalidPattern.wrap(constError("empty pattern"))
+	}
+
+	if strings.ToLower(fields[0]) == "syllable" {
+		return compileSyllableStatement(fields)
+	}
+
+	compiled = &PhonemePattern{}
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == ' ':
+			continue
+		case runes[i] == '.':
+			compiled.boundaries = true
+			compiled.elements = append(compiled.elements, patternElement{kind: patternBoundary})
+		case runes[i] == '*':
+			compiled.elements = append(compiled.elements, patternElement{kind: patternAnything})
+		case runes[i] == 'C':
+			compiled.elements = append(compiled.elements, patternElement{patternPhoneme, phoneme_classes["consonant"]})
+		case runes[i] == 'V':
+			compiled.elements = append(compiled.elements, patternElement{patternPhoneme, nucleusPhonemes()})
+		case runes[i] == '[':
+			end := slices.Index(runes[i:], ']')
+			if end == -1 {
+				return nil, InvalidPattern.wrap(constError("missing ] in " + pattern))
+			}
+			class, ok := phonemeClass(string(runes[i+1 : i+end]))
+			if !ok {
+				return nil, InvalidPattern.wrap(constError("unknown class " + string(runes[i:i+end+1])))
+			}
+			compiled.elements = append(compiled.elements, patternElement{patternPhoneme, class})
+			i += end
+		default:
+			// A run of letters is a run of phonemes
+			j := i
+			for j < len(runes) && !strings.ContainsRune(" .*[CV", runes[j]) {
+				j++
+			}
+			// splitPhonemes skips anything that isn't a letter, so check first
+			letters := strings.Join(slices.Concat(phoneme_classes["consonant"], nucleusPhonemes()), "")
+			for _, a := range runes[i:j] {
+				if !strings.ContainsRune(letters, a) {
+					return nil, InvalidPattern.wrap(constError("unknown letter " + string(a)))
+				}
+			}
+			for _, a := range splitPhonemes(string(runes[i:j])) {
+				if !slices.Contains(phoneme_classes["consonant"], a) && !isNucleusPhoneme(a) {
+					return nil, InvalidPattern.wrap(constError("unknown phoneme " + a))
+				}
+				compiled.elements = append(compiled.elements, patternElement{patternPhoneme, []string{a}})
+			}
+			i = j - 1
+		}
+	}
+
+	return
+}
+
+// syllable <n> has [no] <feature>
+// syllable <n> is <pattern>
+func compileSyllableStatement(fields []string) (compiled *PhonemePattern, err error) {
+	if len(fields) < 4 {
+		return nil, InvalidPattern.wrap(constError("expected syllable <number> has <feature>"))
+	}
+
+	compiled = &PhonemePattern{}
+	compiled.syllable, err = strconv.Atoi(fields[1])
+	if err != nil || compiled.syllable == 0 {
+		return nil, InvalidNumber.wrap(constError(fields[1]))
+	}
+
+	switch strings.ToLower(fields[2]) {
+	case "is":
+		compiled.sub, err = CompilePhonemePattern(strings.Join(fields[3:], " "))
+		return
+	case "has":
+		feature := fields[3:]
+		if strings.ToLower(feature[0]) == "no" {
+			// "has no" on its own is missing the feature, it isn't the phonemes n and o
+			if len(feature) == 1 {
+				return nil, InvalidPattern.wrap(constError("expected a feature after no"))
+			}
+			compiled.negate = true
+			feature = feature[1:]
+		}
+		if len(feature) != 1 {
+			return nil, InvalidPattern.wrap(constError(strings.Join(feature, " ")))
+		}
+		compiled.feature = strings.ToLower(feature[0])
+		switch compiled.feature {
+		case "onset", "coda", "cluster":
+			return
+		}
+		// Otherwise, it has to be a class or a phoneme
+		compiled.sub, err = CompilePhonemePattern("* " + feature[0] + " *")
+		if err != nil {
+			_, ok := phonemeClass(strings.Trim(compiled.feature, "[]"))
+			if !ok {
+				return nil, err
+			}
+			compiled.sub, err = CompilePhonemePattern("* [" + strings.Trim(compiled.feature, "[]") + "] *")
+		}
+		return
+	}
+
+	return nil, InvalidPattern.wrap(constError("expected has or is, got " + fields[2]))
+}
+
+// Does the word fit the pattern?
+func (p *PhonemePattern) Match(word Word) bool {
+	syllables := patternSyllables(word)
+	if len(syllables) == 0 {
+		return false
+	}
+
+	// syllable statements
+	if p.syllable != 0 {
+		i := p.syllable - 1
+		if p.syllable < 0 {
+			i = len(syllables) + p.syllable
+		}
+		if i < 0 || i >= len(syllables) {
+			return false
+		}
+		syllable := syllables[i]
+
+		nucleus := slices.IndexFunc(syllable, isNucleusPhoneme)
+		found := false
+		switch p.feature {
+		case "onset":
+			found = nucleus > 0
+		case "cluster":
+			found = nucleus > 1
+		case "coda":
+			found = nucleus != -1 && nucleus < len(syllable)-1
+		default:
+			found = p.sub.matchSyllables([][]string{syllable})
+		}
+		return found != p.negate
+	}
+
+	return p.matchSyllables(syllables)
+}
+
+func (p *PhonemePattern) matchSyllables(syllables [][]string) bool {
+	// Turn it into one long line of phonemes, with a "." between syllables if needed
+	line := []string{}
+	for i, a := range syllables {
+		if i != 0 && p.boundaries {
+			line = append(line, ".")
+		}
+		line = append(line, a...)
+	}
+	return matchPatternElements(p.elements, line)
+}
+
+// Simple backtracking matcher.  The whole line must match
+func matchPatternElements(elements []patternElement, line []string) bool {
+	if len(elements) == 0 {
+		return len(line) == 0
+	}
+
+	switch elements[0].kind {
+	case patternAnything:
+		for i := 0; i <= len(line); i++ {
+			if matchPatternElements(elements[1:], line[i:]) {
+				return true
+			}
+		}
+		return false
+	case patternBoundary:
+		return len(line) > 0 && line[0] == "." && matchPatternElements(elements[1:], line[1:])
+	}
+
+	return len(line) > 0 && slices.Contains(elements[0].phonemes, line[0]) &&
+		matchPatternElements(elements[1:], line[1:])
+}
+
+// The syllables of a word, each broken into phonemes.  Each word in a multiword word has its own syllables
+func patternSyllables(word Word) (syllables [][]string) {
+	breakdown := strings.ToLower(strings.Split(word.Syllables, " or ")[0])
+	for _, a := range strings.Fields(breakdown) {
+		for _, b := range strings.Split(a, "-") {
+			if len(b) > 0 {
+				syllables = append(syllables, splitPhonemes(b))
+			}
+		}
+	}
+	return
+}
+
+func phonemeClass(name string) (class []string, ok bool) {
+	name = strings.ToLower(name)
+	name = strings.TrimSuffix(name, "s")
+	switch name {
+	case "pseudovowel":
+		name = "psuedovowel"
+	case "nucleu", "nuclei":
+		return nucleusPhonemes(), true
+	}
+	class, ok = phoneme_classes[name]
+	return
+}
+
+func nucleusPhonemes() []string {
+	return slices.Concat(phoneme_classes["vowel"], phoneme_classes["diphthong"], phoneme_classes["psuedovowel"])
+}
@@ -0,0 +1,135 @@
+package fwew_lib
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type PhonemeKind int
+
+const (
+	PhonemeConsonant PhonemeKind = iota
+	PhonemeEjective
+	PhonemeVowel
+	PhonemeDiphthong
+	PhonemePsuedovowel
+	PhonemeTiftang
+	PhonemeUnknown // letters Na'vi doesn't have
+)
+
+// One phoneme.  Text is lowercase, Start and End are byte offsets into what was tokenized
+type PhonemeToken struct {
+	Text  string
+	Kind  PhonemeKind
+	Start int
+	End   int
+}
+
+// Letters that go together
+var phonemeDigraphs = map[string]PhonemeKind{
+	"kx": PhonemeEjective, "px": PhonemeEjective, "tx": PhonemeEjective,
+	"ts": PhonemeConsonant, "ng": PhonemeConsonant, "ch": PhonemeConsonant, "sh": PhonemeConsonant,
+	"ll": PhonemePsuedovowel, "rr": PhonemePsuedovowel,
+	"aw": PhonemeDiphthong, "ay": PhonemeDiphthong, "ew": PhonemeDiphthong, "ey": PhonemeDiphthong,
+}
+
+// Break Na'vi text into phonemes.  Spaces, dashes and punctuation aren't phonemes,
+// but they do keep letters apart.  Words in the dictionary are split where their syllables are,
+// so a word with kan-ga in it won't get an ng
+func TokenizePhonemes(text string) (tokens []PhonemeToken) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	breaks := map[int]bool{}
+	for _, loc := range dialectWordRegex.FindAllStringIndex(text, -1) {
+		word := strings.ToLower(text[loc[0]:loc[1]])
+		for _, a := range dictionarySyllableBreaks(word) {
+			breaks[loc[0]+a] = true
+		}
+	}
+
+	return tokenizePhonemes(text, breaks)
+}
+
+// Where the dictionary puts syllable breaks in a word, as byte offsets.
+// Only works if the root is all in one piece in the word
+func dictionarySyllableBreaks(word string) (breaks []int) {
+	_, matches, err := TranslateFromNaviHashHelper(&dictHashLoose, 0, []string{word}, true, false, true)
+	if err != nil || len(matches) == 0 || len(matches[0]) < 2 {
+		return
+	}
+
+	syllables := strings.ToLower(strings.Split(matches[0][1].Syllables, " or ")[0])
+	if strings.Contains(syllables, " ") {
+		return
+	}
+	start := strings.Index(word, strings.ReplaceAll(syllables, "-", ""))
+	if start == -1 {
+		return
+	}
+
+	offset := start
+	for _, a := range strings.Split(syllables, "-") {
+		offset += len(a)
+		breaks = append(breaks, offset)
+	}
+	return
+}
+
+// The tokenizer itself.  breaks are byte offsets that a digraph can't cross
+func tokenizePhonemes(text string, breaks map[int]bool) (tokens []PhonemeToken) {
+	type letter struct {
+		r          rune
+		start, end int
+	}
+	letters := []letter{}
+	for i, r := range text {
+		letters = append(letters, letter{unicode.ToLower(r), i, i + utf8.RuneLen(r)})
+	}
+
+	isLetter := func(r rune) bool {
+		return unicode.IsLetter(r) || r == '\'' || r == '’' || r == '‘'
+	}
+
+	for i := 0; i < len(letters); i++ {
+		a := letters[i]
+		if !isLetter(a.r) {
+			continue
+		}
+
+		if i+1 < len(letters) && letters[i+1].start == a.end && !breaks[a.end] {
+			pair := string([]rune{a.r, letters[i+1].r})
+			if kind, ok := phonemeDigraphs[pair]; ok {
+				use := true
+				switch kind {
+				case PhonemePsuedovowel:
+					// After a vowel it's a coda and an onset, not a psuedovowel
+					use = len(tokens) == 0 || tokens[len(tokens)-1].End != a.start || !isNucleusPhoneme(tokens[len(tokens)-1].Text)
+				case PhonemeDiphthong:
+					// mawey is ma-wey, not maw-ey
+					use = i+2 >= len(letters) || breaks[letters[i+1].end] || !is_vowel(letters[i+2].r)
+				}
+				if use {
+					tokens = append(tokens, PhonemeToken{pair, kind, a.start, letters[i+1].end})
+					i++
+					continue
+				}
+			}
+		}
+
+		token := PhonemeToken{string(a.r), PhonemeUnknown, a.start, a.end}
+		switch {
+		case a.r == '\'' || a.r == '’' || a.r == '‘':
+			token.Text = "'"
+			token.Kind = PhonemeTiftang
+		case is_vowel(a.r):
+			token.Kind = PhonemeVowel
+		case strings.ContainsRune("ptkbdgmnrlwyfvszh", a.r):
+			token.Kind = PhonemeConsonant
+		}
+		tokens = append(tokens, token)
+	}
+
+	return
+}
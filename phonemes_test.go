@@ -0,0 +1,67 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenizePhonemes(t *testing.T) {
+	CacheDictHash()
+	got := TokenizePhonemes("Ma 'Eylan, kaltxì!")
+	want := []PhonemeToken{
+		{"m", PhonemeConsonant, 0, 1},
+		{"a", PhonemeVowel, 1, 2},
+		{"'", PhonemeTiftang, 3, 4},
+		{"ey", PhonemeDiphthong, 4, 6},
+		{"l", PhonemeConsonant, 6, 7},
+		{"a", PhonemeVowel, 7, 8},
+		{"n", PhonemeConsonant, 8, 9},
+		{"k", PhonemeConsonant, 11, 12},
+		{"a", PhonemeVowel, 12, 13},
+		{"l", PhonemeConsonant, 13, 14},
+		{"tx", PhonemeEjective, 14, 16},
+		{"ì", PhonemeVowel, 16, 18},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TokenizePhonemes() = %v, want %v", got, want)
+	}
+
+	got = TokenizePhonemes("mllte")
+	if len(got) != 4 || got[1].Text != "ll" || got[1].Kind != PhonemePsuedovowel {
+		t.Errorf("TokenizePhonemes() = %v, want a psuedovowel", got)
+	}
+}
+
+func Test_tokenizePhonemes(t *testing.T) {
+	tests := []struct {
+		text   string
+		breaks map[int]bool
+		want   []string
+	}{
+		{"kanga", nil, []string{"k", "a", "ng", "a"}},
+		{"kanga", map[int]bool{3: true}, []string{"k", "a", "n", "g", "a"}},
+		{"kan-ga", nil, []string{"k", "a", "n", "g", "a"}},
+		{"utsa", map[int]bool{2: true}, []string{"u", "t", "s", "a"}},
+		{"mawey", nil, []string{"m", "a", "w", "ey"}},
+		{"kelku", nil, []string{"k", "e", "l", "k", "u"}},
+		{"chash", nil, []string{"ch", "a", "sh"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got := []string{}
+			for _, a := range tokenizePhonemes(tt.text, tt.breaks) {
+				got = append(got, a.Text)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tokenizePhonemes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_dictionarySyllableBreaks(t *testing.T) {
+	CacheDictHash()
+	if got := dictionarySyllableBreaks("tsmukan"); !reflect.DeepEqual(got, []int{4, 7}) {
+		t.Errorf("dictionarySyllableBreaks() = %v, want [4 7]", got)
+	}
+}
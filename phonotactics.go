@@ -19,6 +19,21 @@ var to_reef_lax_u = []string{"ú", "û", "ü", "ů", "ū"}
 
 var letters_map = map[string]string{}
 
+// Phonemes sorted into their classes (used by phoneme patterns)
+var phoneme_classes = map[string][]string{
+	"consonant": {"p", "t", "k", "px", "tx", "kx", "'", "b", "d", "g",
+		"m", "n", "ng", "r", "l", "w", "y", "f", "v", "s", "z", "ts", "h", "ch", "sh"},
+	"vowel":       {"a", "ä", "e", "i", "ì", "o", "u", "ù"},
+	"diphthong":   {"aw", "ay", "ew", "ey"},
+	"psuedovowel": {"ll", "rr"},
+	"ejective":    {"px", "tx", "kx"},
+	"plosive":     {"p", "t", "k", "px", "tx", "kx", "'", "b", "d", "g"},
+	"fricative":   {"f", "v", "s", "z", "ts", "h", "ch", "sh"},
+	"nasal":       {"m", "n", "ng"},
+	"liquid":      {"l", "r"},
+	"approximant": {"w", "y"},
+}
+
 func MakeSyllableBreakdown(syllables []string) string {
 	syllable_breakdown_temp := ""
 	for i, a := range syllables {
@@ -58,14 +73,81 @@ func ResolveFakePsuedovowels(syllable_breakdown string) string {
 	return syllable_breakdown
 }
 
-// See if a word is phonotactically valid in Na'vi
-func IsValidNaviHelper(word string, lang string) string {
-	// Protect against odd language values
-	if _, ok := message_valid[lang]; !ok {
-		lang = "en" // default to English
+// Rule IDs for the things that can be wrong with a word
+const (
+	RuleNonNaviLetters       = "non-navi-letters"
+	RuleWrongDiacritic       = "wrong-diacritic"
+	RuleNoNuclei             = "no-nuclei"
+	RuleInvalidConsonants    = "invalid-consonants"
+	RuleNeededVowel          = "needed-vowel"
+	RulePsuedovowelOnset     = "psuedovowel-onset"
+	RulePsuedovowelCoda      = "psuedovowel-coda"
+	RuleDoubleConsonant      = "double-consonant"
+	RuleIdenticalAdjacent    = "identical-adjacent"
+	RulePsuedovowelConsonant = "psuedovowel-consonant"
+)
+
+type Verdict int
+
+const (
+	VerdictValid   Verdict = iota // Fine in Na'vi
+	VerdictWarning                // Only works in productive compounds
+	VerdictInvalid                // Can't be Na'vi
+)
+
+// One way a word breaks (or bends) Na'vi phonotactics.
+// Notes that don't change the verdict have Severity VerdictValid
+type Violation struct {
+	Rule       string
+	Severity   Verdict
+	Offset     int    // rune offset into the word (-1 if it can't be pinned down)
+	Length     int    // how many runes it covers
+	Text       string // the letters, boundary or breakdown it's about
+	Suggestion string // a Na'vi letter to use instead, if there is one
+}
+
+// Everything the phonotactics checker found out about a word
+type ValidationResult struct {
+	Word            string
+	Verdict         Verdict
+	Breakdown       string // syllables separated by dashes, like "tsam-si-yu"
+	Syllables       []string
+	Forest          bool   // works in forest dialect
+	ReefOnly        bool   // has reef-only letters like b, d, g or ù
+	ForestBreakdown string // what a reef-only word looks like in forest dialect
+	Violations      []Violation
+}
+
+// Where is this bit in the original word?
+func violationAt(word string, text string) (offset int, length int) {
+	runes := []rune(strings.ToLower(word))
+	needle := []rune(text)
+	for i := 0; i+len(needle) <= len(runes); i++ {
+		if string(runes[i:i+len(needle)]) == text {
+			return i, len(needle)
+		}
 	}
+	return -1, len(needle)
+}
+
+// How many letters come before this point in a (possibly compressed) breakdown?
+func breakdownOffset(breakdown string, end int) int {
+	return len([]rune(decompress(strings.ReplaceAll(breakdown[:end], "-", ""))))
+}
+
+func (r *ValidationResult) fail(rule string, offset int, length int, text string) {
+	r.Verdict = VerdictInvalid
+	r.Forest = false
+	r.Violations = append(r.Violations, Violation{rule, VerdictInvalid, offset, length, text, ""})
+}
+
+// See if a word is phonotactically valid in Na'vi
+func ValidateNavi(word string) (result ValidationResult) {
+	initLettersMap()
+
+	result.Word = word
+	result.Forest = true
 
-	oldWord := word
 	// Phase 0: Clean up the word
 	word = strings.ToLower(word)
 	word = strings.Trim(word, " ")
@@ -91,25 +173,17 @@ func IsValidNaviHelper(word string, lang string) string {
 	word = strings.TrimSuffix(word, "+")
 
 	// Normalize diacritics
-	for _, a := range to_umlaut_a {
-		if strings.Contains(word, a) {
-			message := strings.ReplaceAll(message_non_navi_letters[lang], "{oldWord}", oldWord)
-			message = strings.ReplaceAll(message, "{nonNaviLetters}", a)
-			return "❌ " + message + " " + strings.ReplaceAll(use_this_diacritic[lang], "{letter}", "ä")
-		}
-	}
-	for _, i := range to_lax_i {
-		if strings.Contains(word, i) {
-			message := strings.ReplaceAll(message_non_navi_letters[lang], "{oldWord}", oldWord)
-			message = strings.ReplaceAll(message, "{nonNaviLetters}", i)
-			return "❌ " + message + " " + strings.ReplaceAll(use_this_diacritic[lang], "{letter}", "ì")
-		}
-	}
-	for _, u := range to_reef_lax_u {
-		if strings.Contains(word, u) {
-			message := strings.ReplaceAll(message_non_navi_letters[lang], "{oldWord}", oldWord)
-			message = strings.ReplaceAll(message, "{nonNaviLetters}", u)
-			return "❌ " + message + " " + strings.ReplaceAll(use_this_diacritic[lang], "{letter}", "ù")
+	for _, diacritics := range []struct {
+		letters []string
+		navi    string
+	}{{to_umlaut_a, "ä"}, {to_lax_i, "ì"}, {to_reef_lax_u, "ù"}} {
+		for _, a := range diacritics.letters {
+			if strings.Contains(word, a) {
+				offset, length := violationAt(result.Word, a)
+				result.fail(RuleWrongDiacritic, offset, length, a)
+				result.Violations[0].Suggestion = diacritics.navi
+				return
+			}
 		}
 	}
 
@@ -139,9 +213,9 @@ func IsValidNaviHelper(word string, lang string) string {
 
 	// ERROR 1a: letters not in Na'vi
 	if len(nonNaviLetters) > 0 {
-		message := strings.ReplaceAll(message_non_navi_letters[lang], "{oldWord}", oldWord)
-		message = strings.ReplaceAll(message, "{nonNaviLetters}", nonNaviLetters)
-		return "❌ " + message
+		offset, _ := violationAt(result.Word, string([]rune(nonNaviLetters)[0]))
+		result.fail(RuleNonNaviLetters, offset, 1, nonNaviLetters)
+		return
 	}
 
 	// Phase 1: don't confuse the digraph compression things
@@ -184,9 +258,9 @@ func IsValidNaviHelper(word string, lang string) string {
 
 	// ERROR 1b: letters not in Na'vi
 	if badLetters != "" {
-		message := strings.ReplaceAll(message_non_navi_letters[lang], "{oldWord}", oldWord)
-		message = strings.ReplaceAll(message, "{nonNaviLetters}", badLetters)
-		return "❌ " + message
+		offset, _ := violationAt(result.Word, string([]rune(badLetters)[0]))
+		result.fail(RuleNonNaviLetters, offset, 1, badLetters)
+		return
 	}
 
 	// Phase 2: Compress digraphs and divide into syllable boundaries
@@ -217,8 +291,8 @@ func IsValidNaviHelper(word string, lang string) string {
 
 	// ERROR 2: No syllable nuclei
 	if len(word_nuclei) == 0 {
-		message := strings.ReplaceAll(message_no_nuclei[lang], "{oldWord}", oldWord)
-		return "❌ " + message
+		result.fail(RuleNoNuclei, 0, len([]rune(result.Word)), "")
+		return
 	}
 
 	// Phase 2.1: Go through syllable boundaries
@@ -229,9 +303,13 @@ func IsValidNaviHelper(word string, lang string) string {
 		if b, ok := letters_map[a]; ok {
 			syllable_breakdown = syllable_breakdown + b
 		} else { // ERROR 3: Invalid consonant combination
-			message := strings.ReplaceAll(message_invalid_consonants[lang], "{oldWord}", oldWord)
-			message = strings.ReplaceAll(message, "{badConsonants}", strings.ToLower(decompress(a)))
-			return "❌ " + message
+			badConsonants := strings.ToLower(decompress(a))
+			offset, length := violationAt(result.Word, badConsonants)
+			if offset == -1 {
+				offset = breakdownOffset(strings.ReplaceAll(syllable_breakdown, " ", ""), len(strings.ReplaceAll(syllable_breakdown, " ", "")))
+			}
+			result.fail(RuleInvalidConsonants, offset, length, badConsonants)
+			return
 		}
 		if i < len(word_nuclei) {
 			syllable_breakdown = syllable_breakdown + string(word_nuclei[i])
@@ -255,13 +333,21 @@ func IsValidNaviHelper(word string, lang string) string {
 	}
 
 	// ERROR 4a: Incomplete syllables
-	if !contains[0] {
-		syllables[0] += "•"
+	neededVowel := func(i int) {
+		offset := breakdownOffset(syllable_breakdown, len(syllable_breakdown)-len(syllables[len(syllables)-1]))
+		if i == 0 {
+			offset = 0
+		}
+		length := len([]rune(decompress(syllables[i])))
+		syllables[i] += "•"
 		syllable_breakdown_2 := MakeSyllableBreakdown(syllables)
 		syllable_breakdown_2 = NoDoubleDiphthongs(syllable_breakdown_2)
-		message := strings.ReplaceAll(message_needed_vowel[lang], "{oldWord}", oldWord)
-		message = strings.ReplaceAll(message, "{breakdown}", strings.ToLower(decompress(syllable_breakdown_2)))
-		return "❌ " + message
+		result.fail(RuleNeededVowel, offset, length, strings.ToLower(decompress(syllable_breakdown_2)))
+	}
+
+	if !contains[0] {
+		neededVowel(0)
+		return
 	}
 
 	if !contains[1] {
@@ -274,12 +360,8 @@ func IsValidNaviHelper(word string, lang string) string {
 		}
 
 		if !can_end_a_word {
-			syllables[len(syllables)-1] += "•"
-			syllable_breakdown_2 := MakeSyllableBreakdown(syllables)
-			syllable_breakdown_2 = NoDoubleDiphthongs(syllable_breakdown_2)
-			message := strings.ReplaceAll(message_needed_vowel[lang], "{oldWord}", oldWord)
-			message = strings.ReplaceAll(message, "{breakdown}", strings.ToLower(decompress(syllable_breakdown_2)))
-			return "❌ " + message
+			neededVowel(len(syllables) - 1)
+			return
 		}
 
 		can_coda := false
@@ -292,12 +374,8 @@ func IsValidNaviHelper(word string, lang string) string {
 		}
 
 		if !can_coda {
-			syllables[len(syllables)-1] += "•"
-			syllable_breakdown_2 := MakeSyllableBreakdown(syllables)
-			syllable_breakdown_2 = NoDoubleDiphthongs(syllable_breakdown_2)
-			message := strings.ReplaceAll(message_needed_vowel[lang], "{oldWord}", oldWord)
-			message = strings.ReplaceAll(message, "{breakdown}", strings.ToLower(decompress(syllable_breakdown_2)))
-			return "❌ " + message
+			neededVowel(len(syllables) - 1)
+			return
 		}
 
 		syllable_breakdown = MakeSyllableBreakdown(syllables)
@@ -308,27 +386,40 @@ func IsValidNaviHelper(word string, lang string) string {
 
 	syllable_breakdown = ResolveFakePsuedovowels(syllable_breakdown)
 
-	if strings.Contains(syllable_breakdown, "-0-") || strings.Contains(syllable_breakdown, "-1-") ||
-		strings.HasPrefix(syllable_breakdown, "0") || strings.HasPrefix(syllable_breakdown, "1") ||
-		strings.HasSuffix(syllable_breakdown, "-0") || strings.HasSuffix(syllable_breakdown, "-1") {
-		message := strings.ReplaceAll(message_psuedovowels_must_onset[lang], "{oldWord}", oldWord)
-		message = strings.ReplaceAll(message, "{breakdown}", strings.ToLower(decompress(syllable_breakdown)))
-		return "❌ " + message
+	for _, a := range []string{"-0-", "-1-"} {
+		if i := strings.Index(syllable_breakdown, a); i != -1 {
+			result.fail(RulePsuedovowelOnset, breakdownOffset(syllable_breakdown, i), 2, strings.ToLower(decompress(syllable_breakdown)))
+			return
+		}
+	}
+	if strings.HasPrefix(syllable_breakdown, "0") || strings.HasPrefix(syllable_breakdown, "1") {
+		result.fail(RulePsuedovowelOnset, 0, 2, strings.ToLower(decompress(syllable_breakdown)))
+		return
+	}
+	if strings.HasSuffix(syllable_breakdown, "-0") || strings.HasSuffix(syllable_breakdown, "-1") {
+		result.fail(RulePsuedovowelOnset, breakdownOffset(syllable_breakdown, len(syllable_breakdown)-1), 2, strings.ToLower(decompress(syllable_breakdown)))
+		return
 	}
 
 	// Finally, psuedovowels cannot accept codas
 	for _, a := range letters_end {
-		if a != "" && (strings.Contains(syllable_breakdown, "0"+a) || strings.Contains(syllable_breakdown, "1"+a)) {
-			message := strings.ReplaceAll(message_psuedovowels_cant_coda[lang], "{oldWord}", oldWord)
-			message = strings.ReplaceAll(message, "{breakdown}", strings.ToLower(decompress(syllable_breakdown)))
-			return "❌ " + message
+		if a == "" {
+			continue
+		}
+		for _, b := range []string{"0" + a, "1" + a} {
+			if i := strings.Index(syllable_breakdown, b); i != -1 {
+				result.fail(RulePsuedovowelCoda, breakdownOffset(syllable_breakdown, i), len([]rune(decompress(b))), strings.ToLower(decompress(syllable_breakdown)))
+				return
+			}
 		}
 	}
 
-	forestWarning := ""
-
-	if strings.Contains(syllable_breakdown, "0-r") || strings.Contains(syllable_breakdown, "1-l") {
-		forestWarning = message_psuedovowel_and_consonant[lang]
+	for _, a := range []string{"0-r", "1-l"} {
+		if i := strings.Index(syllable_breakdown, a); i != -1 {
+			result.Violations = append(result.Violations, Violation{RulePsuedovowelConsonant, VerdictValid,
+				breakdownOffset(syllable_breakdown, i), 3, strings.ToLower(decompress(a)), ""})
+			break
+		}
 	}
 
 	// If you reach here, the word is valid
@@ -337,7 +428,7 @@ func IsValidNaviHelper(word string, lang string) string {
 	syllable_breakdown = strings.ReplaceAll(syllable_breakdown, "ng", "0")
 
 	isReef := false
-	if strings.ContainsAny(syllable_breakdown, "bdg") || strings.Contains(oldWord, "ch") || strings.Contains(oldWord, "sh") {
+	if strings.ContainsAny(syllable_breakdown, "bdg") || strings.Contains(result.Word, "ch") || strings.Contains(result.Word, "sh") {
 		syllable_breakdown = strings.ReplaceAll(syllable_breakdown, "tsy", "ch")
 		syllable_breakdown = strings.ReplaceAll(syllable_breakdown, "sy", "sh")
 		isReef = true
@@ -358,10 +449,15 @@ func IsValidNaviHelper(word string, lang string) string {
 		syllable_breakdown = strings.ReplaceAll(syllable_breakdown, "y-"+string(a), "-y"+string(a))
 	}
 
+	result.Breakdown = syllable_breakdown
+	result.Syllables = strings.Split(syllable_breakdown, "-")
+	result.ReefOnly = isReef
+	result.Forest = !isReef
+
 	// If reef dialect is present, show what forest looks like
-	syllable_forest := ""
+	letterCheck := syllable_breakdown
 	if isReef {
-		syllable_forest = strings.ReplaceAll(syllable_breakdown, "sh", "sy")
+		syllable_forest := strings.ReplaceAll(syllable_breakdown, "sh", "sy")
 		syllable_forest = strings.ReplaceAll(syllable_forest, "ng", "0")
 		syllable_forest = strings.ReplaceAll(syllable_forest, "ch", "tsy")
 		syllable_forest = strings.ReplaceAll(syllable_forest, "b", "px")
@@ -377,100 +473,157 @@ func IsValidNaviHelper(word string, lang string) string {
 		syllable_forest = strings.ReplaceAll(syllable_forest, "ì-i", "ì-yi")
 		syllable_forest = strings.ReplaceAll(syllable_forest, "0", "ng")
 		syllable_forest = strings.ReplaceAll(syllable_forest, "ù", "u")
-		syllable_forest = strings.ReplaceAll(message_reef_dialect[lang], "{breakdown}", syllable_forest)
-	}
-
-	letterCheck := syllable_breakdown
-	if isReef {
+		result.ForestBreakdown = syllable_forest
 		letterCheck = syllable_forest
 	}
 
 	// Check for things like 'e-wll-lok or ngrr-ro or tì-kan-nuä
 	for _, consonant := range []string{"k", "kx", "l", "m", "n", "ng", "p", "px", "r", "t", "tx", "w", "y"} {
 		boundary := consonant + "-" + consonant
-		if strings.Contains(syllable_breakdown, boundary) {
-			message := strings.ReplaceAll(message_warning[lang], "{oldWord}", oldWord)
-			message = strings.ReplaceAll(message, "{boundary}", boundary)
-			message = strings.ReplaceAll(message, "{breakdown}", syllable_breakdown)
-			return "⚠️ " + message
-		}
-
-		if len([]rune(consonant)) > 1 {
-			if strings.Contains(syllable_breakdown, boundary[:len([]rune(boundary))-1]) {
-				message := strings.ReplaceAll(message_warning[lang], "{oldWord}", oldWord)
-				message = strings.ReplaceAll(message, "{boundary}", boundary[:len(boundary)-1])
-				message = strings.ReplaceAll(message, "{breakdown}", syllable_breakdown)
-				return "⚠️ " + message
-			}
+		if len([]rune(consonant)) > 1 && !strings.Contains(syllable_breakdown, boundary) {
+			boundary = boundary[:len([]rune(boundary))-1]
+		}
+		if i := strings.Index(syllable_breakdown, boundary); i != -1 {
+			result.Verdict = VerdictWarning
+			result.Violations = append(result.Violations, Violation{RuleDoubleConsonant, VerdictWarning,
+				breakdownOffset(syllable_breakdown, i), len([]rune(strings.ReplaceAll(boundary, "-", ""))), boundary, ""})
+			return
 		}
 	}
 
 	// Identical adjacent vowels mean reef Na'vi
-	if forestWarning == "" {
-		found := false
+	if len(result.Violations) == 0 {
+		identical := -1
 		for _, a := range []string{"a", "ä", "e", "i", "ì", "o", "u", "ù", "k", "kx", "l", "m", "n", "ng", "p", "px", "r", "t", "tx", "w", "y"} {
-			if strings.Contains(letterCheck, a+"-"+a) {
-				found = true
-				forestWarning = message_identical_adjacent_letters[lang]
+			if i := strings.Index(letterCheck, a+"-"+a); i != -1 {
+				identical = i
 				break
 			}
 		}
 		// px-p, tx-t, kx-k
-		if !found {
+		if identical == -1 {
 			for _, a := range [][]string{{"kx", "k"}, {"px", "p"}, {"tx", "t"}} {
-				if strings.Contains(letterCheck, a[0]+"-"+a[1]) {
-					found = true
-					forestWarning = message_identical_adjacent_letters[lang]
+				if i := strings.Index(letterCheck, a[0]+"-"+a[1]); i != -1 {
+					identical = i
 					break
 				}
 			}
 		}
-		if !found {
-			if strings.Contains(letterCheck, "i-ì") || strings.Contains(letterCheck, "ì-i") {
-				forestWarning = message_identical_adjacent_letters[lang]
+		if identical == -1 {
+			for _, a := range []string{"i-ì", "ì-i"} {
+				if i := strings.Index(letterCheck, a); i != -1 {
+					identical = i
+					break
+				}
 			}
 		}
+		if identical != -1 {
+			offset := -1
+			if !isReef {
+				offset = breakdownOffset(letterCheck, identical)
+			}
+			result.Violations = append(result.Violations, Violation{RuleIdenticalAdjacent, VerdictValid, offset, 2, "", ""})
+		}
+	}
+
+	return
+}
+
+// Render the result the way IsValidNavi always has, in the given language
+func (r ValidationResult) Message(lang string) string {
+	// Protect against odd language values
+	if _, ok := message_valid[lang]; !ok {
+		lang = "en" // default to English
+	}
+
+	for _, v := range r.Violations {
+		var message string
+		switch v.Rule {
+		case RuleWrongDiacritic:
+			message = strings.ReplaceAll(message_non_navi_letters[lang], "{nonNaviLetters}", v.Text)
+			message += " " + strings.ReplaceAll(use_this_diacritic[lang], "{letter}", v.Suggestion)
+		case RuleNonNaviLetters:
+			message = strings.ReplaceAll(message_non_navi_letters[lang], "{nonNaviLetters}", v.Text)
+		case RuleNoNuclei:
+			message = message_no_nuclei[lang]
+		case RuleInvalidConsonants:
+			message = strings.ReplaceAll(message_invalid_consonants[lang], "{badConsonants}", v.Text)
+		case RuleNeededVowel:
+			message = strings.ReplaceAll(message_needed_vowel[lang], "{breakdown}", v.Text)
+		case RulePsuedovowelOnset:
+			message = strings.ReplaceAll(message_psuedovowels_must_onset[lang], "{breakdown}", v.Text)
+		case RulePsuedovowelCoda:
+			message = strings.ReplaceAll(message_psuedovowels_cant_coda[lang], "{breakdown}", v.Text)
+		case RuleDoubleConsonant:
+			message = strings.ReplaceAll(message_warning[lang], "{boundary}", v.Text)
+			message = strings.ReplaceAll(message, "{breakdown}", r.Breakdown)
+			return "⚠️ " + strings.ReplaceAll(message, "{oldWord}", r.Word)
+		default:
+			continue
+		}
+		return "❌ " + strings.ReplaceAll(message, "{oldWord}", r.Word)
+	}
+
+	syllable_forest := ""
+	if r.ReefOnly {
+		syllable_forest = strings.ReplaceAll(message_reef_dialect[lang], "{breakdown}", r.ForestBreakdown)
 	}
 
-	syllable_count := len(strings.Split(syllable_breakdown, "-"))
-	message := valid_message(syllable_count, lang)
-	message = strings.ReplaceAll(message, "{oldWord}", oldWord)
-	message = strings.ReplaceAll(message, "{breakdown}", syllable_breakdown)
+	message := valid_message(len(r.Syllables), lang)
+	message = strings.ReplaceAll(message, "{oldWord}", r.Word)
+	message = strings.ReplaceAll(message, "{breakdown}", r.Breakdown)
 	message = strings.ReplaceAll(message, "{syllable_forest}", syllable_forest)
-	message = message + forestWarning
+
+	// Only one note fits at the end
+	for _, v := range r.Violations {
+		if v.Rule == RulePsuedovowelConsonant {
+			return "✅ " + message + message_psuedovowel_and_consonant[lang]
+		} else if v.Rule == RuleIdenticalAdjacent {
+			return "✅ " + message + message_identical_adjacent_letters[lang]
+		}
+	}
 
 	return "✅ " + message
 }
 
-func IsValidNavi(word string, lang string, two_thousand_limit bool) string {
-	// Let it know of valid syllable boundaries
-	if len(letters_map) == 0 {
-		for _, a := range letters_end {
-			for _, b := range letters_start {
+// Same as ValidateNavi, but as a localized message
+func IsValidNaviHelper(word string, lang string) string {
+	return ValidateNavi(word).Message(lang)
+}
+
+// Let it know of valid syllable boundaries
+func initLettersMap() {
+	if len(letters_map) != 0 {
+		return
+	}
+	for _, a := range letters_end {
+		for _, b := range letters_start {
+			// Do not assume a thing comes at the end of a word if it doesn't have to
+			if !(a != "" && b == "") {
+				letters_map[a+b] = a + "-" + b
+			}
+		}
+		for _, b := range cluster_1 {
+			for _, c := range cluster_2 {
 				// Do not assume a thing comes at the end of a word if it doesn't have to
 				if !(a != "" && b == "") {
-					letters_map[a+b] = a + "-" + b
-				}
-			}
-			for _, b := range cluster_1 {
-				for _, c := range cluster_2 {
-					// Do not assume a thing comes at the end of a word if it doesn't have to
-					if !(a != "" && b == "") {
-						letters_map[a+b+c] = a + "-" + b + c
-					}
+					letters_map[a+b+c] = a + "-" + b + c
 				}
 			}
 		}
+	}
 
-		// Reef dialect can make words like "adge" and "egdu"
-		for _, a := range []string{"B", "D", "G"} {
-			for _, b := range []string{"B", "D", "G"} {
-				if a != b {
-					letters_map[a+b] = a + "-" + b
-				}
+	// Reef dialect can make words like "adge" and "egdu"
+	for _, a := range []string{"B", "D", "G"} {
+		for _, b := range []string{"B", "D", "G"} {
+			if a != b {
+				letters_map[a+b] = a + "-" + b
 			}
 		}
 	}
+}
+
+func IsValidNavi(word string, lang string, two_thousand_limit bool) string {
 	results := ""
 	for i, a := range strings.Split(word, " ") {
 		newLine := IsValidNaviHelper(a, lang) + "\n"
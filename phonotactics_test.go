@@ -0,0 +1,71 @@
+package fwew_lib
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateNavi(t *testing.T) {
+	tests := []struct {
+		word      string
+		verdict   Verdict
+		breakdown string
+		reefOnly  bool
+		rule      string
+		offset    int
+	}{
+		{"tsamsiyu", VerdictValid, "tsam-si-yu", false, "", 0},
+		{"mawey", VerdictValid, "ma-wey", false, "", 0},
+		{"adge", VerdictValid, "ad-ge", true, "", 0},
+		{"aa", VerdictValid, "a-a", false, RuleIdenticalAdjacent, 0},
+		{"tìkannuä", VerdictWarning, "tì-kan-nu-ä", false, RuleDoubleConsonant, 4},
+		{"jakesully", VerdictInvalid, "", false, RuleInvalidConsonants, 0},
+		{"takkx", VerdictInvalid, "", false, RuleNeededVowel, 3},
+		{"rrta", VerdictInvalid, "", false, RulePsuedovowelOnset, 0},
+		{"hrrr", VerdictInvalid, "", false, RulePsuedovowelCoda, 1},
+		{"ülo", VerdictInvalid, "", false, RuleWrongDiacritic, 0},
+		{"tx", VerdictInvalid, "", false, RuleNoNuclei, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			got := ValidateNavi(tt.word)
+			if got.Verdict != tt.verdict {
+				t.Errorf("ValidateNavi() Verdict = %v, want %v", got.Verdict, tt.verdict)
+			}
+			if got.Breakdown != tt.breakdown {
+				t.Errorf("ValidateNavi() Breakdown = %v, want %v", got.Breakdown, tt.breakdown)
+			}
+			if got.ReefOnly != tt.reefOnly {
+				t.Errorf("ValidateNavi() ReefOnly = %v, want %v", got.ReefOnly, tt.reefOnly)
+			}
+			if tt.rule == "" {
+				if len(got.Violations) != 0 {
+					t.Errorf("ValidateNavi() Violations = %v, want none", got.Violations)
+				}
+				return
+			}
+			if len(got.Violations) == 0 || got.Violations[0].Rule != tt.rule || got.Violations[0].Offset != tt.offset {
+				t.Errorf("ValidateNavi() Violations = %v, want %s at %d", got.Violations, tt.rule, tt.offset)
+			}
+		})
+	}
+}
+
+func TestIsValidNaviHelper(t *testing.T) {
+	tests := []struct {
+		word   string
+		prefix string
+	}{
+		{"kaltxì", "✅ **kaltxì** Valid: `kal-txì` with 2 syllables"},
+		{"tìkannuä", "⚠️ **tìkannuä** Warning: `tì-kan-nu-ä` `n-n`"},
+		{"qa", "❌ **qa** Has letters not in Na'vi: `q`"},
+		{"adge", "✅ **adge** Valid: `ad-ge` with 2 syllables  (In reef dialect.  Forest dialect atx-kxe)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := IsValidNaviHelper(tt.word, "en"); !strings.HasPrefix(got, tt.prefix) {
+				t.Errorf("IsValidNaviHelper() = %v, want %v", got, tt.prefix)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package fwew_lib
+
+import (
+	"slices"
+	"strings"
+)
+
+// Longest phrase TranslateToNaviHash looks for, in words
+const maxPhraseWords = 6
+
+// Find the longest phrase starting at words[start] that's in a definition, like "thank you" or "be afraid".
+// Steps is how many words it has, 0 if no phrase was found.  Words where one meaning is
+// the whole phrase come before words where the phrase is only part of a meaning
+func TranslateToNaviPhraseHelper(words []string, start int, langCode string) (steps int, results []Word) {
+	for n := min(len(words)-start, maxPhraseWords); n >= 2; n-- {
+		phrase := words[start : start+n]
+		whole := []Word{}
+		partial := []Word{}
+		for _, a := range dictHash2Parenthesis.lang(langCode)[phrase[0]] {
+			for _, b := range dictHashStrict[a] {
+				switch phraseInDefinition(phrase, definitionOf(b, langCode)) {
+				case 2:
+					whole = AppendAndAlphabetize(whole, b)
+				case 1:
+					partial = AppendAndAlphabetize(partial, b)
+				}
+			}
+		}
+
+		for _, a := range partial {
+			if !slices.ContainsFunc(whole, func(b Word) bool { return a.ID == b.ID }) {
+				whole = append(whole, a)
+			}
+		}
+		if len(whole) > 0 {
+			return n, whole
+		}
+	}
+	return 0, nil
+}
+
+// 2 if a meaning in the definition is the phrase, 1 if a meaning has it in it, 0 if it's not there
+func phraseInDefinition(phrase []string, definition string) (found int) {
+	for _, meaning := range strings.FieldsFunc(definition, func(r rune) bool { return r == ',' || r == ';' }) {
+		terms := DeleteEmpty(SearchTerms(meaning, false))
+		if slices.Equal(terms, phrase) {
+			return 2
+		}
+		for i := 0; i+len(phrase) <= len(terms); i++ {
+			if slices.Equal(terms[i:i+len(phrase)], phrase) {
+				found = 1
+				break
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,39 @@
+package fwew_lib
+
+import (
+	"testing"
+)
+
+func TestTranslateToNaviPhrase(t *testing.T) {
+	CacheDictHash()
+	CacheDictHash2()
+	tests := []struct {
+		query string
+		want  [][]string // the query of each group, then its words
+	}{
+		{"thank you", [][]string{{"thank you", "irayo"}}},
+		{"to be afraid", [][]string{{"be afraid", "txopu si"}}},
+		{"to the sky", [][]string{{"to"}, {"the", "kame", "tìtaron"}, {"sky", "sawtute", "taw"}}},
+		{"the sky person runs", [][]string{{"the", "kame", "tìtaron"}, {"sky person", "sawtute"}, {"runs", "tul"}}},
+		{"sky", [][]string{{"sky", "sawtute", "taw"}}},
+	}
+	for _, tt := range tests {
+		got := TranslateToNaviHash(tt.query, "en")
+		if len(got) != len(tt.want) {
+			t.Errorf("TranslateToNaviHash(%q) = %v, want %v", tt.query, got, tt.want)
+			continue
+		}
+		for i, group := range tt.want {
+			if len(got[i]) != len(group) {
+				t.Errorf("TranslateToNaviHash(%q) group %d = %v, want %v", tt.query, i, got[i], group)
+				continue
+			}
+			for j, a := range group {
+				if got[i][j].Navi != a {
+					t.Errorf("TranslateToNaviHash(%q) group %d = %v, want %v", tt.query, i, got[i], group)
+					break
+				}
+			}
+		}
+	}
+}
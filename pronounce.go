@@ -0,0 +1,184 @@
+package fwew_lib
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// How to say a word
+type Pronunciation struct {
+	Word      string
+	Syllables []string // in the dialect asked for
+	Stressed  int      // which syllable is stressed, starting at 1
+	Guessed   bool     // true if the word isn't in the dictionary and the stress is a guess
+	IPA       string   // forest dialect
+	ReefIPA   string
+}
+
+// Romanized phonemes to forest IPA
+var ipaOfPhoneme = map[string]string{
+	// Vowels
+	"a": "a", "ä": "æ", "e": "ɛ", "i": "i", "ì": "ɪ", "o": "o", "u": "u", "ù": "ʊ",
+	// Diphthongs
+	"aw": "aw", "ay": "aj", "ew": "ɛw", "ey": "ɛj",
+	// Psuedovowels
+	"rr": "ṛ", "ll": "ḷ",
+	// Consonants
+	"p": "p", "t": "t", "k": "k", "px": "p'", "tx": "t'", "kx": "k'", "'": "ʔ",
+	"m": "m", "n": "n", "ng": "ŋ", "r": "ɾ", "l": "l", "w": "w", "y": "j",
+	"f": "f", "v": "v", "s": "s", "z": "z", "ts": "t͡s", "h": "h",
+	// Reef dialect
+	"b": "b", "d": "d", "g": "g", "ch": "tʃ", "sh": "ʃ",
+	// Rarities and stress marks
+	"õ": "õ", "ˈ": "ˈ", "ˌ": "ˌ",
+}
+
+// Unstressed prefixes, for guessing the stress of words not in the dictionary
+var unstressedPrefixes = []string{"munsna", "sna", "fne", "tì", "nì", "le", "fì", "tsa", "pe", "fay", "tsay", "pay", "ay", "me", "pxe"}
+
+// Syllables, stress and IPA for a word.  If it's not in the dictionary, it's a guess.
+// Conjugated words keep the stress of their root.  DialectBoth gives the interdialect syllables
+func Pronounce(word string, dialect Dialect) (result Pronunciation, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+	return pronounce(word, dialect)
+}
+
+func pronounce(word string, dialect Dialect) (result Pronunciation, err error) {
+	word = clean(word)
+	if len(word) == 0 || strings.Contains(word, " ") {
+		return result, NoResults
+	}
+
+	_, matches, err := TranslateFromNaviHashHelper(&dictHashLoose, 0, []string{word}, true, false, true)
+	if err == nil && len(matches) > 0 && len(matches[0]) > 1 {
+		return PronounceConjugated(word, likeliestRoot(matches[0][1:]), dialect)
+	}
+
+	return PronounceConjugated(word, Word{}, dialect)
+}
+
+// The root that needs the fewest affixes, and then the longest one.
+// taronyut is taronyu with -t, not taron with -yu and -t
+func likeliestRoot(roots []Word) Word {
+	affixes := func(a Word) int {
+		return len(a.Affixes.Prefix) + len(a.Affixes.Infix) + len(a.Affixes.Suffix) + len(a.Affixes.Lenition)
+	}
+	return slices.MinFunc(roots, func(a, b Word) int {
+		if affixes(a) != affixes(b) {
+			return affixes(a) - affixes(b)
+		}
+		return len([]rune(b.Navi)) - len([]rune(a.Navi))
+	})
+}
+
+// Pronounce what the user actually typed (the first Word of a TranslateFromNaviHash result),
+// using the root it was found under (the second Word) to know where the stress goes.
+// An empty root means the stress has to be guessed
+func PronounceConjugated(surface string, root Word, dialect Dialect) (result Pronunciation, err error) {
+	result.Word = surface
+
+	forestIPA := ""
+	forestSyllables := []string{}
+	stressed, err1 := strconv.Atoi(root.Stressed)
+	bareRoot := root.ID != "" && err1 == nil && strings.EqualFold(clean(root.Navi), clean(surface)) &&
+		len(root.Affixes.Prefix)+len(root.Affixes.Infix)+len(root.Affixes.Suffix)+len(root.Affixes.Lenition) == 0
+
+	if bareRoot {
+		// Straight out of the dictionary
+		forestIPA = strings.ReplaceAll(strings.Split(root.IPA, "] or [")[0], "·", "")
+		forestSyllables = strings.Split(strings.ToLower(strings.Split(root.Syllables, " or ")[0]), "-")
+		result.Stressed = stressed
+	} else {
+		validation := ValidateNavi(surface)
+		if validation.Verdict == VerdictInvalid {
+			return result, InvalidNavi.wrap(constError(validation.Violations[0].Rule))
+		}
+
+		if root.ID != "" && err1 == nil && !strings.Contains(root.Navi, " ") {
+			result.Stressed = stressedNucleusOfConjugation(root, stressed) + 1
+		} else {
+			result.Stressed = guessStress(validation.Syllables)
+			result.Guessed = true
+		}
+		result.Stressed = max(min(result.Stressed, len(validation.Syllables)), 1)
+
+		// The IPA is always forest, so ReefMe can do the rest
+		forestSyllables = validation.Syllables
+		if validation.ReefOnly {
+			forestSyllables = strings.Split(validation.ForestBreakdown, "-")
+		}
+		forestIPA = syllablesToIPA(forestSyllables, result.Stressed)
+	}
+
+	result.IPA = forestIPA
+	reef := ReefMe(forestIPA, false)
+	result.ReefIPA = strings.Split(reef[1], "] or [")[0]
+
+	switch dialect {
+	case DialectBoth: // interdialect
+		result.Syllables = ipaToSyllables(ReefMe(forestIPA, true)[0])
+	case DialectReef:
+		result.Syllables = ipaToSyllables(reef[0])
+	default: // forest
+		result.Syllables = forestSyllables
+	}
+
+	return
+}
+
+// Turn a breakdown from ReefMe into plain syllables
+func ipaToSyllables(breakdown string) []string {
+	breakdown = strings.Split(breakdown, " or ")[0]
+	breakdown = strings.ReplaceAll(breakdown, "_", "")
+	return strings.Split(strings.ReplaceAll(breakdown, " ", "-"), "-")
+}
+
+// Guess the stress of a word not in the dictionary.  Most roots are stressed on the first syllable,
+// and prefixes don't take the stress
+func guessStress(syllables []string) int {
+	stressed := 1
+	for stressed < len(syllables) {
+		found := 0
+		for _, a := range unstressedPrefixes {
+			// A prefix can be more than one syllable (munsna-)
+			for end := stressed; end < len(syllables); end++ {
+				if strings.Join(syllables[stressed-1:end], "") == a {
+					found = end - stressed + 1
+					break
+				}
+			}
+			if found > 0 {
+				break
+			}
+		}
+		if found == 0 {
+			break
+		}
+		stressed += found
+	}
+	return stressed
+}
+
+// Forest IPA from romanized syllables
+func syllablesToIPA(syllables []string, stressed int) string {
+	ipa := ""
+	for i, a := range syllables {
+		if i != 0 {
+			ipa += "."
+		}
+		if i == stressed-1 && len(syllables) > 1 {
+			ipa += "ˈ"
+		}
+		phonemes := splitPhonemes(a)
+		for j, b := range phonemes {
+			ipa += ipaOfPhoneme[b]
+			// Stops at the end of a word are unreleased
+			if i == len(syllables)-1 && j == len(phonemes)-1 && j != 0 && strings.Contains("ptk", b) {
+				ipa += "̚"
+			}
+		}
+	}
+	return ipa
+}
@@ -0,0 +1,67 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPronounce(t *testing.T) {
+	CacheDictHash()
+	tests := []struct {
+		word      string
+		dialect   Dialect
+		syllables []string
+		stressed  int
+		guessed   bool
+		ipa       string
+		reefIPA   string
+	}{
+		{"taron", DialectForest, []string{"ta", "ron"}, 1, false, "ˈta.ɾon", "ˈta.ɾon"},
+		{"tìtaron", DialectForest, []string{"tì", "ta", "ron"}, 2, false, "tɪ.ˈta.ɾon", "tɪ.ˈta.ɾon"},
+		{"kaltxì", DialectReef, []string{"kal", "dì"}, 2, false, "kal.ˈt'ɪ", "kal.ˈdɪ"},
+		{"tìfmetok", DialectForest, []string{"tì", "fme", "tok"}, 2, true, "tɪ.ˈfmɛ.tok̚", "tɪ.ˈfmɛ.tok̚"},
+		{"adge", DialectForest, []string{"atx", "kxe"}, 1, true, "ˈat'.k'ɛ", "ˈad.gɛ"},
+		// conjugated, stressed like the root
+		{"taronyut", DialectForest, []string{"ta", "ron", "yut"}, 2, false, "ta.ˈɾon.jut̚", "ta.ˈɾon.jut̚"},
+		{"tìtaronit", DialectForest, []string{"tì", "ta", "ro", "nit"}, 2, false, "tɪ.ˈta.ɾo.nit̚", "tɪ.ˈta.ɾo.nit̚"},
+		{"taronyutsyìp", DialectReef, []string{"ta", "ron", "yu", "chìp"}, 2, false, "ta.ˈɾon.ju.t͡sjɪp̚", "ta.ˈɾon.ju.tʃɪp̚"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			got, err := Pronounce(tt.word, tt.dialect)
+			if err != nil {
+				t.Fatalf("Pronounce() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Syllables, tt.syllables) {
+				t.Errorf("Pronounce() Syllables = %v, want %v", got.Syllables, tt.syllables)
+			}
+			if got.Stressed != tt.stressed || got.Guessed != tt.guessed {
+				t.Errorf("Pronounce() Stressed = %d (guessed %v), want %d (guessed %v)", got.Stressed, got.Guessed, tt.stressed, tt.guessed)
+			}
+			if got.IPA != tt.ipa || got.ReefIPA != tt.reefIPA {
+				t.Errorf("Pronounce() IPA = %s / %s, want %s / %s", got.IPA, got.ReefIPA, tt.ipa, tt.reefIPA)
+			}
+		})
+	}
+
+	if _, err := Pronounce("rrta", DialectForest); err == nil {
+		t.Errorf("Pronounce() expected an error for rrta")
+	}
+}
+
+func Test_guessStress(t *testing.T) {
+	tests := []struct {
+		syllables []string
+		want      int
+	}{
+		{[]string{"ta", "ron"}, 1},
+		{[]string{"tì", "fme", "tok"}, 2},
+		{[]string{"sna", "tì", "fme", "tok"}, 3},
+		{[]string{"le"}, 1},
+	}
+	for _, tt := range tests {
+		if got := guessStress(tt.syllables); got != tt.want {
+			t.Errorf("guessStress(%v) = %d, want %d", tt.syllables, got, tt.want)
+		}
+	}
+}
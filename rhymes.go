@@ -0,0 +1,237 @@
+package fwew_lib
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Options for Rhymes()
+type RhymeOptions struct {
+	Reef  bool // Compare the reef dialect pronunciations instead of forest
+	Limit int  // Maximum number of words in each group (0 means no limit)
+}
+
+// Rhymes grouped by how well they rhyme
+type RhymeResults struct {
+	Perfect       []Word // Identical from the stressed vowel onward
+	Slant         []Word // Same vowels from the stressed vowel onward
+	FinalSyllable []Word // Only the last syllable rhymes
+}
+
+// What a word sounds like from its stressed syllable onward
+type rhymeTail struct {
+	tail  []string // phonemes from the stressed nucleus onward
+	final []string // phonemes from the last nucleus onward
+}
+
+// Find the dictionary words that rhyme with the last word of the input.
+// Conjugated words work too, since stress stays on the root
+func Rhymes(word string, options RhymeOptions) (results RhymeResults, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	words := strings.Split(clean(word), " ")
+	query := words[len(words)-1]
+	if len(query) == 0 {
+		return results, NoResults
+	}
+
+	target, selfIDs := queryRhymeTail(query, options.Reef)
+	if len(target.tail) == 0 {
+		return results, NoResults
+	}
+
+	err = RunOnDict(func(w Word) error {
+		if _, ok := selfIDs[w.ID]; ok {
+			return nil
+		}
+		candidate, ok := wordRhymeTail(w, options.Reef)
+		if !ok {
+			return nil
+		}
+		if slices.Equal(candidate.tail, target.tail) {
+			results.Perfect = append(results.Perfect, w)
+		} else if slices.Equal(rhymeVowels(candidate.tail), rhymeVowels(target.tail)) {
+			results.Slant = append(results.Slant, w)
+		} else if slices.Equal(candidate.final, target.final) {
+			results.FinalSyllable = append(results.FinalSyllable, w)
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	for _, group := range []*[]Word{&results.Perfect, &results.Slant, &results.FinalSyllable} {
+		slices.SortStableFunc(*group, func(a, b Word) int {
+			if AlphabetizeHelper(a.Navi, b.Navi) {
+				return -1
+			} else if AlphabetizeHelper(b.Navi, a.Navi) {
+				return 1
+			}
+			return 0
+		})
+		if options.Limit > 0 && len(*group) > options.Limit {
+			*group = (*group)[:options.Limit]
+		}
+	}
+
+	if len(results.Perfect)+len(results.Slant)+len(results.FinalSyllable) == 0 {
+		err = NoResults
+	}
+
+	return
+}
+
+// Work out the rhyme of what the user typed.  If it's a dictionary word (or a conjugation of one),
+// use its stress.  Otherwise, guess the first syllable.  Also return the IDs of the words it came from
+func queryRhymeTail(query string, reef bool) (target rhymeTail, selfIDs map[string]bool) {
+	selfIDs = map[string]bool{}
+
+	dict := &dictHashStrict
+	if reef {
+		dict = &dictHashLoose
+	}
+
+	surface := query
+	if reef {
+		surface = quickReef(surface)
+	}
+	phonemes := splitPhonemes(surface)
+
+	// Words straight from the dictionary already know their stress
+	if found, ok := (*dict)[query]; ok && len(found) > 0 {
+		for _, a := range found {
+			selfIDs[a.ID] = true
+		}
+		if tail, ok := wordRhymeTail(found[0], reef); ok {
+			return tail, selfIDs
+		}
+	}
+
+	_, matches, err := TranslateFromNaviHashHelper(dict, 0, []string{query}, true, false, reef)
+	if err == nil && len(matches) > 0 && len(matches[0]) > 1 {
+		root := likeliestRoot(matches[0][1:])
+		for _, a := range matches[0][1:] {
+			selfIDs[a.ID] = true
+		}
+		stressed, err := strconv.Atoi(root.Stressed)
+		if err == nil && !strings.Contains(root.Navi, " ") {
+			return newRhymeTail(phonemes, stressedNucleusOfConjugation(root, stressed)), selfIDs
+		}
+	}
+
+	return newRhymeTail(phonemes, 0), selfIDs
+}
+
+// Prefixes and infixes before the stressed syllable push it back.  Suffixes don't move it
+func stressedNucleusOfConjugation(root Word, stressed int) int {
+	nucleus := stressed - 1
+	for _, a := range root.Affixes.Prefix {
+		nucleus += countNuclei(a)
+	}
+
+	if len(root.Affixes.Infix) > 0 && root.InfixLocations != "NULL" {
+		locations := strings.Split(root.InfixLocations, " ")
+		location := locations[len(locations)-1]
+		before01 := countNuclei(strings.Split(location, "<0>")[0])
+		before2 := countNuclei(strings.Split(location, "<2>")[0])
+		for _, a := range root.Affixes.Infix {
+			if secondMap[a] {
+				if before2 <= stressed-1 {
+					nucleus += countNuclei(a)
+				}
+			} else if before01 <= stressed-1 {
+				nucleus += countNuclei(a)
+			}
+		}
+	}
+
+	return nucleus
+}
+
+func countNuclei(input string) (count int) {
+	for _, a := range splitPhonemes(input) {
+		if isNucleusPhoneme(a) {
+			count++
+		}
+	}
+	return
+}
+
+// Find the rhyme of a dictionary word from its syllables and stress
+func wordRhymeTail(word Word, reef bool) (tail rhymeTail, ok bool) {
+	syllables := word.Syllables
+	stressed := 0
+
+	if reef {
+		// ReefMe marks the stressed syllable with underscores
+		syllables = strings.Split(ReefMe(word.IPA, false)[0], " or ")[0]
+		words := strings.Split(syllables, " ")
+		syllables = words[len(words)-1]
+		for i, a := range strings.Split(syllables, "-") {
+			if strings.Contains(a, "__") {
+				stressed = i
+				break
+			}
+		}
+		syllables = strings.ReplaceAll(syllables, "_", "")
+	} else {
+		syllables = strings.Split(syllables, " or ")[0]
+		words := strings.Split(syllables, " ")
+		syllables = words[len(words)-1]
+		if len(words) == 1 {
+			i, err := strconv.Atoi(word.Stressed)
+			if err == nil && i > 0 {
+				stressed = i - 1
+			}
+		} else {
+			// Multiword words only have one stress number, so ask the IPA about the last word
+			ipas := strings.Split(strings.Split(word.IPA, " or ")[0], " ")
+			for i, a := range strings.Split(ipas[len(ipas)-1], ".") {
+				if strings.Contains(a, "ˈ") {
+					stressed = i
+					break
+				}
+			}
+		}
+	}
+
+	phonemes := []string{}
+	for _, a := range strings.Split(strings.ToLower(syllables), "-") {
+		phonemes = append(phonemes, splitPhonemes(a)...)
+	}
+
+	tail = newRhymeTail(phonemes, stressed)
+	return tail, len(tail.tail) > 0
+}
+
+// Cut the phonemes down to the rhyming parts
+func newRhymeTail(phonemes []string, stressedNucleus int) (tail rhymeTail) {
+	nuclei := []int{}
+	for i, a := range phonemes {
+		if isNucleusPhoneme(a) {
+			nuclei = append(nuclei, i)
+		}
+	}
+	if len(nuclei) == 0 {
+		return
+	}
+	if stressedNucleus < 0 || stressedNucleus >= len(nuclei) {
+		stressedNucleus = len(nuclei) - 1
+	}
+	tail.tail = phonemes[nuclei[stressedNucleus]:]
+	tail.final = phonemes[nuclei[len(nuclei)-1]:]
+	return
+}
+
+// Just the vowels, diphthongs and psuedovowels
+func rhymeVowels(phonemes []string) (vowels []string) {
+	for _, a := range phonemes {
+		if isNucleusPhoneme(a) {
+			vowels = append(vowels, a)
+		}
+	}
+	return
+}
@@ -0,0 +1,70 @@
+package fwew_lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_splitPhonemes(t *testing.T) {
+	tests := []struct {
+		word string
+		want []string
+	}{
+		{"kxetse", []string{"kx", "e", "ts", "e"}},
+		{"mawey", []string{"m", "a", "w", "ey"}},
+		{"kllte", []string{"k", "ll", "t", "e"}},
+		{"hrrap", []string{"h", "rr", "a", "p"}},
+		{"tì-ta-ron", []string{"t", "ì", "t", "a", "r", "o", "n"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := splitPhonemes(tt.word); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitPhonemes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRhymes(t *testing.T) {
+	CacheDictHash()
+	tests := []struct {
+		word    string
+		options RhymeOptions
+		tier    string
+		want    string
+	}{
+		{"taron", RhymeOptions{}, "perfect", "tìtaron"},
+		{"tìtaron", RhymeOptions{}, "perfect", "taron"},
+		{"tute", RhymeOptions{}, "slant", "tsmuke"},
+		{"ikran", RhymeOptions{}, "final", "tsmukan"},
+		// conjugated, stressed like the root (taronyu, not taron)
+		{"taronyut", RhymeOptions{}, "slant", "txopu"},
+		{"ayfpom", RhymeOptions{}, "perfect", "yom"},
+		// reef spelling
+		{"kaldì", RhymeOptions{Reef: true}, "perfect", "mì"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			got, err := Rhymes(tt.word, tt.options)
+			if err != nil {
+				t.Fatalf("Rhymes() error = %v", err)
+			}
+			tiers := map[string][]Word{"perfect": got.Perfect, "slant": got.Slant, "final": got.FinalSyllable}
+			found := false
+			for tier, words := range tiers {
+				for _, a := range words {
+					if a.Navi == tt.want && tier == tt.tier {
+						found = true
+					}
+					if a.Navi == tt.word {
+						t.Errorf("Rhymes() rhymed %s with itself", tt.word)
+					}
+				}
+			}
+			if !found {
+				t.Errorf("Rhymes() = %v, want %s in %s", tiers[tt.tier], tt.want, tt.tier)
+			}
+		})
+	}
+	UncacheHashDict()
+}
@@ -0,0 +1,134 @@
+package fwew_lib
+
+import (
+	"strings"
+)
+
+// Turn Na'vi spelling into IPA.
+//
+// Syllables can be split with "." or "-" and the stress marked with "ˈ" (or "ˌ") like in the dictionary,
+// otherwise the word is split like ValidateNavi does and the stress is guessed.
+// Reef spellings (b, d, g, ch, sh, ù) stay reef.  More than one spelling can be given with " or "
+func RomanizationToIPA(navi string) (string, error) {
+	variants := []string{}
+	for _, variant := range strings.Split(strings.ToLower(navi), " or ") {
+		words := []string{}
+		for _, word := range strings.Fields(strings.Trim(variant, "[]")) {
+			ipa, err := romanizedWordToIPA(word)
+			if err != nil {
+				return "", err
+			}
+			words = append(words, ipa)
+		}
+		variants = append(variants, strings.Join(words, " "))
+	}
+	return strings.Join(variants, "] or ["), nil
+}
+
+func romanizedWordToIPA(word string) (string, error) {
+	word = strings.ReplaceAll(word, "’", "'")
+
+	if !strings.ContainsAny(word, ".-ˈˌ") {
+		validation := ValidateNavi(word)
+		if validation.Verdict == VerdictInvalid {
+			return "", InvalidNavi.wrap(constError(word))
+		}
+		return syllablesToIPA(validation.Syllables, guessStress(validation.Syllables)), nil
+	}
+
+	syllables := strings.FieldsFunc(word, func(r rune) bool { return r == '.' || r == '-' })
+	unstressed := strings.NewReplacer("ˈ", "", "ˌ", "").Replace
+	// The checker doesn't know the nasal vowel (võvä')
+	whole := strings.ReplaceAll(unstressed(strings.Join(syllables, "")), "õ", "o")
+	if len(syllables) == 0 || ValidateNavi(whole).Verdict == VerdictInvalid {
+		return "", InvalidNavi.wrap(constError(word))
+	}
+	for _, a := range syllables {
+		if !validSyllable(unstressed(a)) {
+			return "", InvalidNavi.wrap(constError(word))
+		}
+	}
+
+	// The stress marks are already in the syllables
+	if strings.ContainsAny(word, "ˈˌ") {
+		return syllablesToIPA(syllables, 0), nil
+	}
+	return syllablesToIPA(syllables, guessStress(syllables)), nil
+}
+
+// One nucleus, and every phoneme has IPA
+func validSyllable(syllable string) bool {
+	nuclei := 0
+	for _, a := range splitPhonemes(syllable) {
+		if ipaOfPhoneme[a] == "" {
+			return false
+		}
+		if isNucleusPhoneme(a) || a == "õ" {
+			nuclei++
+		}
+	}
+	return nuclei == 1
+}
+
+// Turn IPA into Na'vi spelling.  Stress marks, syllable dots and spaces are kept,
+// so strip them out if only the word is needed.  Reef IPA gives reef spelling.
+// Variants can be separated with " or ", with or without the brackets
+func IPAToRomanization(ipa string) string {
+	ipa = strings.ReplaceAll(ipa, "] or [", " or ")
+	variants := []string{}
+	for _, variant := range strings.Split(ipa, " or ") {
+		words := []string{}
+		for _, word := range strings.Fields(strings.Trim(variant, "[]")) {
+			words = append(words, ipaWordToRomanization(word))
+		}
+		variants = append(variants, strings.Join(words, " "))
+	}
+	return strings.Join(variants, " or ")
+}
+
+func ipaWordToRomanization(word string) (navi string) {
+	word = strings.ReplaceAll(word, "·", "")
+	word = strings.ReplaceAll(word, "̚", "") // unreleased stops
+	runes := []rune(word)
+	next := func(i int) rune {
+		if i < len(runes) {
+			return runes[i]
+		}
+		return 0
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case strings.ContainsRune(".ˈˌ", r):
+			navi += string(r)
+		case r == 't' && next(i+1) == '͡' && next(i+2) == 's':
+			navi += "ts"
+			i += 2
+		case r == 't' && next(i+1) == 'ʃ':
+			navi += "ch"
+			i++
+		case strings.ContainsRune("ptk", r) && next(i+1) == '\'':
+			navi += romanization2[string(runes[i:i+2])]
+			i++
+		case (r == 'r' || r == 'l') && next(i+1) == '̣':
+			// psuedovowel
+			navi += string(r) + string(r)
+			i++
+		case r == 'ṛ' || r == 'ḷ':
+			navi += map[rune]string{'ṛ': "rr", 'ḷ': "ll"}[r]
+		case (r == 'a' || r == 'ɛ') && (next(i+1) == 'j' || next(i+1) == 'w') && !strings.ContainsRune("aɛiɪouʊæ", next(i+2)):
+			// diphthong, unless the j or w starts the next syllable
+			navi += romanization2[string(runes[i:i+2])]
+			i++
+		default:
+			if a, ok := romanization2[string(r)]; ok && a != "" {
+				navi += a
+			} else {
+				navi += string(r)
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,95 @@
+package fwew_lib
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRomanizationToIPA(t *testing.T) {
+	tests := []struct {
+		navi string
+		want string
+	}{
+		{"tìtaron", "tɪ.ˈta.ɾon"},
+		{"ta-ron", "ˈta.ɾon"},
+		{"ˈkal.txì", "ˈkal.t'ɪ"},
+		{"kal.ˈtxì", "kal.ˈt'ɪ"},
+		{"mllte", "ˈml\u0323.tɛ"},
+		{"adge", "ˈad.gɛ"},
+		{"tsmùk", "t͡smʊk̚"},
+		{"sìltsan or ˈsìl.tsa.nä", "ˈsɪl.t͡san] or [ˈsɪl.t͡sa.næ"},
+		{"ˈi.ra.yo si", "ˈi.ɾa.jo si"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.navi, func(t *testing.T) {
+			got, err := RomanizationToIPA(tt.navi)
+			if err != nil {
+				t.Fatalf("RomanizationToIPA() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RomanizationToIPA() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, navi := range []string{"rrta", "ta.qon", "ta-c", "ta.rrn", "t.aron"} {
+		if got, err := RomanizationToIPA(navi); !errors.Is(err, InvalidNavi) {
+			t.Errorf("RomanizationToIPA(%s) = %v, %v, want %v", navi, got, err, InvalidNavi)
+		}
+	}
+}
+
+func TestIPAToRomanization(t *testing.T) {
+	tests := []struct {
+		ipa  string
+		want string
+	}{
+		{"ˈt·a.ɾ·on", "ˈta.ron"},
+		{"kal.ˈt'ɪ", "kal.ˈtxì"},
+		{"ˈml\u0323.tɛ", "ˈmll.te"},
+		{"ˈad.gɛ", "ˈad.ge"},
+		{"ˈma.wɛj", "ˈma.wey"},
+		{"t͡sjal", "tsyal"},
+		{"tʃaʃ", "chash"},
+		{"[ˈɛj.lan] or [ˈʔɛj.lan]", "ˈey.lan or ˈ'ey.lan"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ipa, func(t *testing.T) {
+			if got := IPAToRomanization(tt.ipa); got != tt.want {
+				t.Errorf("IPAToRomanization() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Every word in the dictionary should survive IPA -> romanization -> IPA,
+// and the romanization should be the word itself
+func TestRomanizationRoundTrip(t *testing.T) {
+	normalize := strings.NewReplacer("·", "", "̚", "").Replace
+	unmark := strings.NewReplacer("ˈ", "", "ˌ", "", ".", "", "ù", "u", "õ", "n").Replace
+
+	err := RunOnDict(func(word Word) error {
+		// affixes
+		if strings.HasPrefix(word.Navi, "-") || strings.HasSuffix(word.Navi, "-") {
+			return nil
+		}
+
+		navi := IPAToRomanization(word.IPA)
+		if !slices.Contains(strings.Split(unmark(navi), " or "), strings.ToLower(word.Navi)) {
+			t.Errorf("IPAToRomanization(%s) = %s, want %s", word.IPA, navi, word.Navi)
+		}
+
+		ipa, err := RomanizationToIPA(navi)
+		if err != nil {
+			t.Errorf("RomanizationToIPA(%s) error = %v", navi, err)
+		} else if normalize(ipa) != normalize(word.IPA) {
+			t.Errorf("RomanizationToIPA(%s) = %s, want %s", navi, ipa, word.IPA)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("RunOnDict() error = %v", err)
+	}
+}
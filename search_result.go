@@ -0,0 +1,172 @@
+package fwew_lib
+
+import (
+	"strings"
+)
+
+type MatchKind string
+
+const (
+	MatchExact        MatchKind = "exact"        // a dictionary word as it is
+	MatchDeconjugated MatchKind = "deconjugated" // a dictionary word with affixes
+	MatchMultiword    MatchKind = "multiword"    // a dictionary word with spaces, like irayo si
+	MatchNatlang      MatchKind = "natlang"      // found in the definitions
+	MatchNumeral      MatchKind = "numeral"      // a Na'vi number
+	MatchCompound     MatchKind = "compound"     // not a word, but maybe words stuck together.  Only a guess
+	MatchNone         MatchKind = "none"
+)
+
+// What was found for one part of a search.  Start and End are rune offsets into what was searched.
+// Unlike the [][]Word results, the query isn't a Word at the start of Words
+type SearchResult struct {
+	Query       string          `json:"query"`
+	Start       int             `json:"start"`
+	End         int             `json:"end"`
+	Kind        MatchKind       `json:"kind"`
+	Words       []Word          `json:"words"`
+	Number      int             `json:"number,omitempty"`      // the value, if it's a numeral
+	Suggestions []string        `json:"suggestions,omitempty"` // if nothing was found
+	Compounds   []CompoundSplit `json:"compounds,omitempty"`   // for compound matches, best first.  Words are the parts of the first one
+}
+
+// TranslateFromNaviHash with a SearchResult for every word (or multiword word) of the text.
+// Punctuation isn't searched
+func SearchNavi(text string, checkFixes bool, strict bool, allowReef bool) (results []SearchResult, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+	return searchNavi(text, checkFixes, strict, allowReef, "en")
+}
+
+// The compound glosses are in langCode
+func searchNavi(text string, checkFixes bool, strict bool, allowReef bool, langCode string) (results []SearchResult, err error) {
+	dict := &dictHashLoose
+	if !allowReef {
+		dict = &dictHashStrict
+	} else if strict {
+		dict = &dictHashStrictReef
+	}
+
+	var buckets, exact map[string][]int
+	var words []phonemeWord
+
+	results = []SearchResult{}
+	for _, token := range tokenizeSentence(text) {
+		if token.Kind != TokenWord && token.Kind != TokenMultiword {
+			continue
+		}
+		allWords := strings.Fields(clean(token.Text))
+		if len(allWords) == 0 {
+			continue
+		}
+
+		result := SearchResult{Query: token.Text, Start: token.Start, End: token.End, Kind: MatchNone, Words: []Word{}}
+		_, found, err2 := TranslateFromNaviHashHelper(dict, 0, allWords, checkFixes, strict, allowReef)
+		if err2 == nil && len(found) > 0 {
+			result.Words = append(result.Words, found[0][1:]...)
+		}
+
+		switch {
+		case len(result.Words) == 0:
+		case token.Kind == TokenMultiword || strings.Contains(result.Words[0].Navi, " "):
+			result.Kind = MatchMultiword
+		case searchResultExact(result.Words):
+			result.Kind = MatchExact
+		default:
+			result.Kind = MatchDeconjugated
+		}
+
+		if n, ok := searchResultNumber(allWords[0]); ok && len(allWords) == 1 {
+			result.Kind = MatchNumeral
+			result.Number = n
+		}
+
+		if result.Kind == MatchNone && len(allWords) == 1 {
+			if splits, err2 := splitCompound(allWords[0], langCode); err2 == nil {
+				result.Kind = MatchCompound
+				result.Compounds = splits
+				for _, a := range splits[0].Parts {
+					result.Words = append(result.Words, a.Word)
+				}
+			}
+		}
+
+		if result.Kind == MatchNone {
+			if words == nil {
+				buckets, exact, words, err = phonemeIndex()
+				if err != nil {
+					return
+				}
+			}
+			options := SpellCheckOptions{AllowReef: allowReef, MaxSuggestions: 5}
+			result.Suggestions = spellSuggestions(allWords[0], options, buckets, exact, words)
+		}
+
+		results = append(results, result)
+	}
+	return
+}
+
+// TranslateToNaviHash with a SearchResult for every word or phrase that was searched
+func SearchNatlang(text string, langCode string) (results []SearchResult) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	lower := []rune(strings.ToLower(text))
+	offset := 0
+	results = []SearchResult{}
+	for _, group := range translateToNaviHash(text, langCode) {
+		result := SearchResult{Query: group[0].Navi, Start: -1, End: -1, Kind: MatchNatlang, Words: group[1:]}
+		if len(result.Words) == 0 {
+			result.Kind = MatchNone
+		}
+
+		// Find where it was in the text.  Phrases are found by their first and last words
+		queryWords := strings.Fields(result.Query)
+		if start := searchRunes(lower, []rune(queryWords[0]), offset); start != -1 {
+			end := start + len([]rune(queryWords[0]))
+			if last := queryWords[len(queryWords)-1]; len(queryWords) > 1 {
+				if a := searchRunes(lower, []rune(last), end); a != -1 {
+					end = a + len([]rune(last))
+				}
+			}
+			result.Start, result.End = start, end
+			result.Query = string([]rune(text)[start:end])
+			offset = end
+		}
+
+		results = append(results, result)
+	}
+	return
+}
+
+// Index of needle in haystack, starting at from, or -1
+func searchRunes(haystack []rune, needle []rune, from int) int {
+	for i := from; i+len(needle) <= len(haystack); i++ {
+		if string(haystack[i:i+len(needle)]) == string(needle) {
+			return i
+		}
+	}
+	return -1
+}
+
+// If one of the words has no affixes at all
+func searchResultExact(words []Word) bool {
+	for _, a := range words {
+		if len(a.Affixes.Prefix) == 0 && len(a.Affixes.Infix) == 0 && len(a.Affixes.Suffix) == 0 && len(a.Affixes.Lenition) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// The value of a Na'vi number.  NaviToNumber also takes words that only start with a number,
+// so it has to give back (about) the same word
+func searchResultNumber(word string) (int, bool) {
+	n, err := NaviToNumber(word)
+	if err != nil {
+		return 0, false
+	}
+	navi, err := NumberToNavi(n)
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(strings.ToLower(word), "a"), "a")
+	return n, err == nil && strings.HasPrefix(trimmed, navi) && len(trimmed)-len(navi) <= 1
+}
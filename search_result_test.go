@@ -0,0 +1,77 @@
+package fwew_lib
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSearchNavi(t *testing.T) {
+	CacheDictHash()
+	PhonemeDistros()
+	text := "Tute kameie, irayo si! Mune tsmukän."
+	got, err := SearchNavi(text, true, false, false)
+	if err != nil {
+		t.Fatalf("SearchNavi() error = %v", err)
+	}
+
+	want := []struct {
+		query string
+		kind  MatchKind
+		root  string
+	}{
+		{"Tute", MatchExact, "tute"},
+		{"kameie", MatchDeconjugated, "kame"},
+		{"irayo si", MatchMultiword, "irayo si"},
+		{"Mune", MatchNumeral, ""},
+		{"tsmukän", MatchNone, ""},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("SearchNavi() = %v, want %d results", got, len(want))
+	}
+	runes := []rune(text)
+	for i, tt := range want {
+		if got[i].Query != tt.query || string(runes[got[i].Start:got[i].End]) != tt.query || got[i].Kind != tt.kind {
+			t.Errorf("SearchNavi() %d = %q %s, want %q %s", i, got[i].Query, got[i].Kind, tt.query, tt.kind)
+		}
+		if tt.root != "" && (len(got[i].Words) == 0 || got[i].Words[0].Navi != tt.root) {
+			t.Errorf("SearchNavi() %q words = %v, want %s", tt.query, got[i].Words, tt.root)
+		}
+	}
+	if got[3].Number != 2 {
+		t.Errorf("SearchNavi() mune = %d, want 2", got[3].Number)
+	}
+	if len(got[4].Suggestions) == 0 || got[4].Suggestions[0] != "tsmukan" {
+		t.Errorf("SearchNavi() tsmukän suggestions = %v, want tsmukan first", got[4].Suggestions)
+	}
+
+	b, err := json.Marshal(got[1])
+	if err != nil || !strings.Contains(string(b), `"kind":"deconjugated"`) || !strings.Contains(string(b), `"Infix":["ei"]`) {
+		t.Errorf("json.Marshal() = %s, %v", b, err)
+	}
+}
+
+func TestSearchNatlang(t *testing.T) {
+	CacheDictHash()
+	CacheDictHash2()
+	text := "Thank you, sky person xyzzy"
+	got := SearchNatlang(text, "en")
+
+	want := []struct {
+		query string
+		kind  MatchKind
+	}{
+		{"Thank you", MatchNatlang},
+		{"sky person", MatchNatlang},
+		{"xyzzy", MatchNone},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("SearchNatlang() = %v, want %d results", got, len(want))
+	}
+	runes := []rune(text)
+	for i, tt := range want {
+		if got[i].Query != tt.query || string(runes[got[i].Start:got[i].End]) != tt.query || got[i].Kind != tt.kind {
+			t.Errorf("SearchNatlang() %d = %q %s, want %q %s", i, got[i].Query, got[i].Kind, tt.query, tt.kind)
+		}
+	}
+}
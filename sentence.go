@@ -0,0 +1,172 @@
+package fwew_lib
+
+import (
+	"strings"
+	"unicode"
+)
+
+type SentenceTokenKind int
+
+const (
+	TokenWord SentenceTokenKind = iota
+	TokenMultiword
+	TokenPunctuation
+	TokenQuote
+)
+
+// A piece of a sentence.  Start and End are rune offsets, so Text is []rune(text)[Start:End].
+// Spaces aren't tokens
+type SentenceToken struct {
+	Text  string
+	Start int
+	End   int
+	Kind  SentenceTokenKind
+}
+
+// Quote marks that can't be tìftangs
+const sentenceQuotes = `"“”„«»‹›`
+
+// Split a sentence into words, multiword words (like tì'i'a si), punctuation and quote marks.
+// A ' is a tìftang unless the word is only in the dictionary without it
+func TokenizeSentence(text string) (tokens []SentenceToken) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+	return tokenizeSentence(text)
+}
+
+func tokenizeSentence(text string) (tokens []SentenceToken) {
+	runes := []rune(text)
+	isWordRune := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'' || r == '’' || r == '‘' || r == '-'
+	}
+
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case unicode.IsSpace(runes[i]):
+			continue
+		case strings.ContainsRune(sentenceQuotes, runes[i]):
+			tokens = append(tokens, SentenceToken{string(runes[i]), i, i + 1, TokenQuote})
+			continue
+		case !isWordRune(runes[i]):
+			tokens = append(tokens, SentenceToken{string(runes[i]), i, i + 1, TokenPunctuation})
+			continue
+		}
+
+		end := i
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+		tokens = append(tokens, sentenceWord(runes, i, end)...)
+		i = end - 1
+	}
+
+	return mergeMultiwords(runes, tokens)
+}
+
+// A word, maybe with quote marks stuck to it.  A ' next to the word is a quote mark
+// if the word is known without it, unless the dictionary has it with it ('eylan, tìprrte')
+func sentenceWord(runes []rune, start int, end int) (tokens []SentenceToken) {
+	isQuote := func(r rune) bool { return r == '\'' || r == '’' || r == '‘' }
+
+	wordStart, wordEnd := start, end
+	for wordStart < wordEnd && isQuote(runes[wordStart]) {
+		wordStart++
+	}
+	for wordEnd > wordStart && isQuote(runes[wordEnd-1]) {
+		wordEnd--
+	}
+
+	if wordStart != wordEnd {
+		core := string(runes[wordStart:wordEnd])
+		known := spellLookup(core, true) != nil
+		isTiftang := func(with string) bool {
+			_, ok := dictHashStrict[strings.ToLower(strings.NewReplacer("’", "'", "‘", "'").Replace(with))]
+			return ok || !known
+		}
+		if wordStart != start && isTiftang("'"+core) {
+			wordStart--
+		}
+		if wordEnd != end && isTiftang(core+"'") {
+			wordEnd++
+		}
+	}
+
+	for i := start; i < wordStart; i++ {
+		tokens = append(tokens, SentenceToken{string(runes[i]), i, i + 1, TokenQuote})
+	}
+	if wordStart != wordEnd {
+		tokens = append(tokens, SentenceToken{string(runes[wordStart:wordEnd]), wordStart, wordEnd, TokenWord})
+	}
+	for i := wordEnd; i < end; i++ {
+		tokens = append(tokens, SentenceToken{string(runes[i]), i, i + 1, TokenQuote})
+	}
+	return
+}
+
+// Words that make one dictionary entry together become one token, as long as there's no punctuation between them
+func mergeMultiwords(runes []rune, tokens []SentenceToken) []SentenceToken {
+	merged := []SentenceToken{}
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].Kind != TokenWord {
+			merged = append(merged, tokens[i])
+			continue
+		}
+
+		// The words from here to the next punctuation
+		allWords := []string{}
+		for j := i; j < len(tokens) && tokens[j].Kind == TokenWord; j++ {
+			allWords = append(allWords, clean(tokens[j].Text))
+		}
+
+		steps, _, err := TranslateFromNaviHashHelper(&dictHashLoose, 0, allWords, true, false, true)
+		if err != nil || steps == 0 || steps >= len(allWords) {
+			merged = append(merged, tokens[i])
+			continue
+		}
+
+		start, end := tokens[i].Start, tokens[i+steps].End
+		merged = append(merged, SentenceToken{string(runes[start:end]), start, end, TokenMultiword})
+		i += steps
+	}
+	return merged
+}
+
+// Translate the tokens from TokenizeSentence.  There's one result per token, in the same order.
+// Punctuation and quote marks get nil, the rest get the query and its words like TranslateFromNaviHash.
+// If none of the words are found, it's NoResults
+func TranslateFromNaviTokens(tokens []SentenceToken, checkFixes bool, strict bool, allowReef bool) (results [][]Word, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	dict := &dictHashLoose
+	if !allowReef {
+		dict = &dictHashStrict
+	} else if strict {
+		dict = &dictHashStrictReef
+	}
+
+	found := false
+	results = make([][]Word, len(tokens))
+	for i, token := range tokens {
+		if token.Kind != TokenWord && token.Kind != TokenMultiword {
+			continue
+		}
+		allWords := strings.Fields(clean(token.Text))
+		if len(allWords) == 0 {
+			continue
+		}
+		_, newWords, err2 := TranslateFromNaviHashHelper(dict, 0, allWords, checkFixes, strict, allowReef)
+		if err2 != nil {
+			return nil, err2
+		}
+		if len(newWords) > 0 {
+			results[i] = newWords[0]
+			found = found || len(newWords[0]) > 1
+		}
+	}
+
+	if !found {
+		err = NoResults
+	}
+	return
+}
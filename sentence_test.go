@@ -0,0 +1,65 @@
+package fwew_lib
+
+import (
+	"testing"
+)
+
+func TestTokenizeSentence(t *testing.T) {
+	CacheDictHash()
+	PhonemeDistros()
+	text := "Oel \"irayo si\", 'kaltxì' ma 'Eylan! Tìprrte' lu."
+	got := TokenizeSentence(text)
+
+	want := []SentenceToken{
+		{"Oel", 0, 3, TokenWord},
+		{"\"", 4, 5, TokenQuote},
+		{"irayo si", 5, 13, TokenMultiword},
+		{"\"", 13, 14, TokenQuote},
+		{",", 14, 15, TokenPunctuation},
+		{"'", 16, 17, TokenQuote},
+		{"kaltxì", 17, 23, TokenWord},
+		{"'", 23, 24, TokenQuote},
+		{"ma", 25, 27, TokenWord},
+		{"'Eylan", 28, 34, TokenWord},
+		{"!", 34, 35, TokenPunctuation},
+		{"Tìprrte'", 36, 44, TokenWord},
+		{"lu", 45, 47, TokenWord},
+		{".", 47, 48, TokenPunctuation},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("TokenizeSentence() = %v, want %v", got, want)
+	}
+	runes := []rune(text)
+	for i := range want {
+		if got[i] != want[i] || string(runes[got[i].Start:got[i].End]) != got[i].Text {
+			t.Errorf("TokenizeSentence() token %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestTranslateFromNaviTokens(t *testing.T) {
+	CacheDictHash()
+	PhonemeDistros()
+	tokens := TokenizeSentence("Irayo si, txopu soli.")
+	got, err := TranslateFromNaviTokens(tokens, true, false, false)
+	if err != nil {
+		t.Fatalf("TranslateFromNaviTokens() error = %v", err)
+	}
+	if len(got) != len(tokens) {
+		t.Fatalf("TranslateFromNaviTokens() = %d results, want %d", len(got), len(tokens))
+	}
+
+	want := []string{"irayo si", "", "txopu si", ""}
+	for i, navi := range want {
+		switch {
+		case navi == "" && got[i] != nil:
+			t.Errorf("TranslateFromNaviTokens() %q = %v, want nil", tokens[i].Text, got[i])
+		case navi != "" && (len(got[i]) < 2 || got[i][1].Navi != navi):
+			t.Errorf("TranslateFromNaviTokens() %q = %v, want %s", tokens[i].Text, got[i], navi)
+		}
+	}
+
+	if _, err := TranslateFromNaviTokens(TokenizeSentence("Blorp zzyx."), true, false, false); err != NoResults {
+		t.Errorf("TranslateFromNaviTokens() error = %v, want %v", err, NoResults)
+	}
+}
@@ -0,0 +1,166 @@
+package fwew_lib
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+type SpellStatus int
+
+const (
+	SpellKnown   SpellStatus = iota // in the dictionary, or a conjugated form of something in it
+	SpellUnknown                    // could be Na'vi, but it's not in the dictionary
+	SpellInvalid                    // can't be Na'vi
+)
+
+type SpellCheckOptions struct {
+	AllowReef      bool // reef spellings are fine
+	MaxSuggestions int  // 0 means 5
+}
+
+// One word of the text.  Start and End are byte offsets, so Text is always text[Start:End]
+type SpellToken struct {
+	Text        string
+	Start       int
+	End         int
+	Status      SpellStatus
+	Words       []Word      // what it is, if it's known
+	Violations  []Violation // why it can't be Na'vi, if it's invalid
+	Suggestions []string    // best first, with the same capitalization as Text
+}
+
+// Phonemes that are easy to mix up
+var spellConfusables = map[string][]string{
+	"a": {"ä"}, "ä": {"a", "e"}, "e": {"ä"}, "i": {"ì"}, "ì": {"i"},
+	"t": {"tx"}, "tx": {"t"}, "p": {"px"}, "px": {"p"}, "k": {"kx"}, "kx": {"k"},
+	"l": {"ll"}, "ll": {"l"}, "r": {"rr"}, "rr": {"r"}, "n": {"ng"}, "ng": {"n"},
+}
+
+// Check every word in a text.  Punctuation and spaces aren't tokens, so nothing in between is changed
+func SpellCheck(text string, options SpellCheckOptions) (tokens []SpellToken, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	if options.MaxSuggestions <= 0 {
+		options.MaxSuggestions = 5
+	}
+
+	var buckets, exact map[string][]int
+	var words []phonemeWord
+
+	for _, loc := range dialectWordRegex.FindAllStringIndex(text, -1) {
+		token := SpellToken{Start: loc[0], End: loc[1]}
+		token.Words = spellLookup(text[loc[0]:loc[1]], options.AllowReef)
+
+		// Quote marks aren't tìftangs.  Try without the one at the end first, since 'eylan starts with one
+		if len(token.Words) == 0 {
+			isQuote := func(r rune) bool { return strings.ContainsRune("'’‘", r) }
+			original := text[loc[0]:loc[1]]
+			end := loc[0] + len(strings.TrimRightFunc(original, isQuote))
+			start := loc[1] - len(strings.TrimLeftFunc(original, isQuote))
+			if end != loc[1] {
+				token.End = end
+				token.Words = spellLookup(text[token.Start:token.End], options.AllowReef)
+			}
+			if len(token.Words) == 0 && start != loc[0] && start < end {
+				token.Start = start
+				token.Words = spellLookup(text[token.Start:token.End], options.AllowReef)
+			}
+			if token.Start >= token.End {
+				continue
+			}
+		}
+		token.Text = text[token.Start:token.End]
+
+		if len(token.Words) > 0 {
+			token.Status = SpellKnown
+			tokens = append(tokens, token)
+			continue
+		}
+
+		validation := ValidateNavi(strings.ToLower(token.Text))
+		token.Status = SpellUnknown
+		if validation.Verdict == VerdictInvalid || (validation.ReefOnly && !options.AllowReef) {
+			token.Status = SpellInvalid
+			token.Violations = validation.Violations
+		}
+
+		if words == nil {
+			buckets, exact, words, err = phonemeIndex()
+			if err != nil {
+				return
+			}
+		}
+		for _, a := range spellSuggestions(token.Text, options, buckets, exact, words) {
+			token.Suggestions = append(token.Suggestions, keepCapital(token.Text, a))
+		}
+		tokens = append(tokens, token)
+	}
+
+	return
+}
+
+// The dictionary words a word could be, conjugated or not
+func spellLookup(word string, allowReef bool) []Word {
+	word = clean(word)
+	if len(word) == 0 || strings.Contains(word, " ") {
+		return nil
+	}
+
+	dict := &dictHashStrict
+	if allowReef {
+		dict = &dictHashLoose
+	}
+	_, matches, err := TranslateFromNaviHashHelper(dict, 0, []string{word}, true, false, allowReef)
+	if err != nil || len(matches) == 0 || len(matches[0]) < 2 {
+		return nil
+	}
+	return matches[0][1:]
+}
+
+// Words close to an unknown word.  Easy mistakes (ì for i, t for tx, a missing tìftang) that make a known word
+// come first, then a dropped phoneme, then dictionary words one phoneme away
+func spellSuggestions(word string, options SpellCheckOptions, buckets map[string][]int, exact map[string][]int, words []phonemeWord) (suggestions []string) {
+	type suggestion struct {
+		word string
+		cost int
+	}
+	found := []suggestion{}
+	seen := map[string]bool{strings.ToLower(word): true}
+	try := func(phonemes []string, cost int) {
+		candidate := strings.Join(phonemes, "")
+		if seen[candidate] {
+			return
+		}
+		seen[candidate] = true
+		if len(spellLookup(candidate, options.AllowReef)) > 0 {
+			found = append(found, suggestion{candidate, cost})
+		}
+	}
+
+	phonemes := splitPhonemes(word)
+	for i, a := range phonemes {
+		for _, b := range spellConfusables[a] {
+			try(slices.Replace(slices.Clone(phonemes), i, i+1, b), 1)
+		}
+		// Missing tìftang between vowels, or at the start
+		if isNucleusPhoneme(a) && (i == 0 || isNucleusPhoneme(phonemes[i-1])) {
+			try(slices.Insert(slices.Clone(phonemes), i, "'"), 1)
+		}
+		try(slices.Delete(slices.Clone(phonemes), i, i+1), 2)
+	}
+
+	for _, a := range phonologicalNeighbours(strings.ToLower(word), buckets, exact, words) {
+		if !seen[strings.ToLower(a.Navi)] {
+			seen[strings.ToLower(a.Navi)] = true
+			found = append(found, suggestion{strings.ToLower(a.Navi), 3})
+		}
+	}
+
+	sort.SliceStable(found, func(i, j int) bool { return found[i].cost < found[j].cost })
+	for _, a := range found[:min(len(found), options.MaxSuggestions)] {
+		suggestions = append(suggestions, a.word)
+	}
+	return
+}
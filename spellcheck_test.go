@@ -0,0 +1,56 @@
+package fwew_lib
+
+import (
+	"testing"
+)
+
+func TestSpellCheck(t *testing.T) {
+	CacheDictHash()
+	text := "Oel tsmukän, 'Eylan' taronyut. Jakesully txaron!"
+	got, err := SpellCheck(text, SpellCheckOptions{})
+	if err != nil {
+		t.Fatalf("SpellCheck() error = %v", err)
+	}
+
+	want := []struct {
+		text       string
+		status     SpellStatus
+		suggestion string
+	}{
+		{"Oel", SpellKnown, ""},
+		{"tsmukän", SpellUnknown, "tsmukan"},
+		{"'Eylan", SpellKnown, ""},
+		{"taronyut", SpellKnown, ""},
+		{"Jakesully", SpellInvalid, ""},
+		{"txaron", SpellUnknown, "taron"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("SpellCheck() = %v, want %d tokens", got, len(want))
+	}
+	for i, tt := range want {
+		if got[i].Text != tt.text || text[got[i].Start:got[i].End] != tt.text || got[i].Status != tt.status {
+			t.Errorf("SpellCheck() token %d = %q (%d), want %q (%d)", i, got[i].Text, got[i].Status, tt.text, tt.status)
+		}
+		if tt.suggestion != "" && (len(got[i].Suggestions) == 0 || got[i].Suggestions[0] != tt.suggestion) {
+			t.Errorf("SpellCheck() %q suggestions = %v, want %v first", tt.text, got[i].Suggestions, tt.suggestion)
+		}
+	}
+	if len(got[4].Violations) == 0 {
+		t.Errorf("SpellCheck() %q has no violations", got[4].Text)
+	}
+}
+
+func TestPhonemeIndexCache(t *testing.T) {
+	CacheDictHash()
+	_, _, first, err := phonemeIndex()
+	if err != nil || len(first) == 0 {
+		t.Fatalf("phonemeIndex() = %d words, error = %v", len(first), err)
+	}
+	if _, _, second, _ := phonemeIndex(); &second[0] != &first[0] {
+		t.Errorf("phonemeIndex() made the index again")
+	}
+	UncacheHashDict()
+	if _, ok := derivedIndexes["phonemes"]; ok {
+		t.Errorf("UncacheHashDict() didn't clear the phoneme index")
+	}
+}
@@ -121,6 +121,8 @@ func init() {
 	texts["c_first"] = "first"
 	texts["c_last"] = "last"
 	texts["c_matches"] = "matches"
+	texts["c_pattern"] = "pattern"
+	texts["c_not-pattern"] = "not-pattern"
 
 	// random
 	texts["n_random"] = "random"
@@ -208,6 +210,8 @@ func init() {
 	texts["not-isDesc"] = "field is excactly not"
 	texts["!=Desc"] = "not equal to"
 	texts["matchesDesc"] = "field matches regexp"
+	texts["patternDesc"] = "field matches phoneme pattern"
+	texts["not-patternDesc"] = "field does not match phoneme pattern"
 	texts["languageDesc"] = "update config file: set language"
 	texts["posFilterDesc"] = "update config file: set part of speech filter"
 	texts["useAffixesDesc"] = "update config file: toggle affix parsing true/false"
@@ -251,6 +255,8 @@ func init() {
 	texts["configValueError"] = "err 14: invalid config value for"
 	texts["invalidNumericError"] = "err 15: invalid numeric digits"
 	texts["downloadError"] = "err 16: could not download dictionary update"
+	texts["invalidPatternError"] = "err 17: invalid pattern"
+	texts["invalidNaviError"] = "err 18: not a valid Na'vi word"
 
 	// main program strings
 	texts["name"] = "fwew"
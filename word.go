@@ -227,6 +227,15 @@ func (w *Word) ToOutputLine(
 	i string,
 	withMarkdown, showIPA, showInfixes, showDashed, showInfDots, showSource, reef bool,
 	langCode string,
+) (output string, err error) {
+	// Not through ToOutputLineWith, an empty langCode still means no definition here
+	return w.toOutputLine(i, withMarkdown, showIPA, showInfixes, showDashed, showInfDots, showSource, reef, langCode)
+}
+
+func (w *Word) toOutputLine(
+	i string,
+	withMarkdown, showIPA, showInfixes, showDashed, showInfDots, showSource, reef bool,
+	langCode string,
 ) (output string, err error) {
 	num := fmt.Sprintf("[%s]", i)
 	nav := w.Navi
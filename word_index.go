@@ -0,0 +1,110 @@
+package fwew_lib
+
+import (
+	"strings"
+)
+
+// Words by their ID and by their pronunciation
+type wordIndex struct {
+	byID  map[string]Word
+	byIPA map[string][]Word
+}
+
+// Stress marks, syllable breaks and the other marks people leave out (or put in) when they type IPA
+var ipaFolds = strings.NewReplacer(
+	"ˈ", "", "ˌ", "", ".", "", "·", "", " ", "", "-", "",
+	"[", "", "]", "", "/", "", "ː", "", ":", "", "͡", "", "̚", "",
+	"ʼ", "'", "’", "'", "ɡ", "g", "r̩", "ṛ", "l̩", "ḷ", "ɹ", "ɾ", "r", "ɾ",
+)
+
+// Fold an IPA transcription so that the same pronunciation always gives the same key.
+// Ejectives keep their mark, so t' and t are different
+func normalizeIPA(ipa string) string {
+	return ipaFolds.Replace(strings.ToLower(strings.TrimSpace(ipa)))
+}
+
+// Made the first time they're used
+func wordIndexes() *wordIndex {
+	return derivedIndex("words", func() (*wordIndex, bool) {
+		return newWordIndex(), len(dictHashStrict) > 0
+	})
+}
+
+func newWordIndex() *wordIndex {
+	wordsByID := map[string]Word{}
+	wordsByIPA := map[string][]Word{}
+
+	add := func(ipa string, word Word) {
+		key := normalizeIPA(ipa)
+		if len(key) == 0 {
+			return
+		}
+		for _, a := range wordsByIPA[key] {
+			if a.ID == word.ID {
+				return
+			}
+		}
+		wordsByIPA[key] = append(wordsByIPA[key], word)
+	}
+
+	for _, words := range dictHashStrict {
+		for _, word := range words {
+			if _, ok := wordsByID[word.ID]; ok {
+				continue
+			}
+			wordsByID[word.ID] = word
+
+			// Every pronunciation, forest and reef
+			for _, ipa := range strings.Split(word.IPA, "] or [") {
+				add(ipa, word)
+				for _, reef := range strings.Split(ReefMe(ipa, false)[1], "] or [") {
+					add(reef, word)
+				}
+			}
+		}
+	}
+	return &wordIndex{byID: wordsByID, byIPA: wordsByIPA}
+}
+
+// The word with this ID, like for permalinks
+func GetWordByID(id string) (word Word, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	word, ok := wordIndexes().byID[strings.TrimSpace(id)]
+	if !ok {
+		err = NoResults
+	}
+	return
+}
+
+// The words with these IDs, in the same order.  IDs that aren't in the dictionary are skipped,
+// and NoResults is only returned if none of them are
+func GetWordsByIDs(ids []string) (words []Word, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	wordsByID := wordIndexes().byID
+	words = []Word{}
+	for _, id := range ids {
+		if word, ok := wordsByID[strings.TrimSpace(id)]; ok {
+			words = append(words, word)
+		}
+	}
+	if len(words) == 0 {
+		err = NoResults
+	}
+	return
+}
+
+// The words pronounced like this.  Forest or reef IPA both work, with or without the stress marks and syllable breaks
+func TranslateFromIPA(ipa string) (words []Word, err error) {
+	universalLock.Lock()
+	defer universalLock.Unlock()
+
+	words = wordIndexes().byIPA[normalizeIPA(ipa)]
+	if len(words) == 0 {
+		err = NoResults
+	}
+	return
+}
@@ -0,0 +1,53 @@
+package fwew_lib
+
+import (
+	"testing"
+)
+
+func TestGetWordByID(t *testing.T) {
+	CacheDictHash()
+	word, err := GetWordByID("10")
+	if err != nil || word.Navi != "taron" {
+		t.Errorf("GetWordByID(10) = %v, %v, want taron", word.Navi, err)
+	}
+	if _, err := GetWordByID("no such id"); err != NoResults {
+		t.Errorf("GetWordByID() error = %v, want %v", err, NoResults)
+	}
+
+	words, err := GetWordsByIDs([]string{"11", "no such id", "4"})
+	if err != nil || len(words) != 2 || words[0].Navi != "taronyu" || words[1].Navi != "'ampi" {
+		t.Errorf("GetWordsByIDs() = %v, %v, want taronyu and 'ampi", words, err)
+	}
+	if _, err := GetWordsByIDs([]string{"no such id"}); err != NoResults {
+		t.Errorf("GetWordsByIDs() error = %v, want %v", err, NoResults)
+	}
+}
+
+func TestTranslateFromIPA(t *testing.T) {
+	CacheDictHash()
+	tests := []struct {
+		ipa  string
+		want string
+	}{
+		{"ˈt͡smu.kan", "tsmukan"},
+		{"tsmukan", "tsmukan"},
+		{"[ˈtu.tɛ]", "tute"},
+		{"tʼɛp", "txep"},
+		{"dɛp", "txep"}, // reef
+		{"ˈi.ɾa.jo s·i·", "irayo si"},
+		{"irajosi", "irayo si"},
+		{"võ.ˈvæʔ", "vonvä'"}, // second pronunciation
+	}
+	for _, tt := range tests {
+		t.Run(tt.ipa, func(t *testing.T) {
+			words, err := TranslateFromIPA(tt.ipa)
+			if err != nil || len(words) != 1 || words[0].Navi != tt.want {
+				t.Errorf("TranslateFromIPA() = %v, %v, want %v", words, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := TranslateFromIPA("tɛp"); err != NoResults {
+		t.Errorf("TranslateFromIPA() error = %v, want %v", err, NoResults)
+	}
+}